@@ -58,7 +58,7 @@ func main() {
 	setupEventSubscriptions(eventBus)
 
 	logger.WithField("caller", caller).WithField("function", "main").Info("Starting selected renderer")
-	startSelectedRenderer(args.renderer, client, eventBus, args.teamID, args.width, args.height, args.fullscreen)
+	startSelectedRenderer(args.renderer, client, eventBus, client.TeamID(), args.width, args.height, args.fullscreen)
 
 	logger.WithField("caller", caller).WithField("function", "main").Info("Client shutdown completed")
 }
@@ -84,7 +84,7 @@ func parseCommandLineArguments(logger *logrus.Logger, ctx context.Context) *clie
 	flag.StringVar(&args.configPath, "config", "config.json", "Path to configuration file")
 	flag.StringVar(&args.serverAddr, "server", "", "Server address (overrides config)")
 	flag.StringVar(&args.playerName, "name", "Player", "Player name")
-	flag.IntVar(&args.teamID, "team", 0, "Team ID")
+	flag.IntVar(&args.teamID, "team", 0, "Team ID, or -1 to join the smallest team")
 	flag.StringVar(&args.renderer, "renderer", "terminal", "Renderer type: 'terminal' or 'engo'")
 	flag.BoolVar(&args.fullscreen, "fullscreen", false, "Run in fullscreen mode (Engo only)")
 	flag.IntVar(&args.width, "width", 1024, "Window width (Engo only)")
@@ -363,11 +363,22 @@ func (ai *AIClient) executePatrolBehavior() (turnLeft, turnRight bool) {
 func (ai *AIClient) executeBomberBehavior(myShip engine.ShipState) {
 	target := ai.findEnemyPlanet(myShip.Position)
 
+	// Armies can only be beamed down from orbit, so stop and orbit once in range
+	if ai.shouldBeamDown(myShip, target) {
+		ai.client.SendPlayerInput(network.PlayerInputData{
+			FireWeapon: -1,
+			BeamDown:   true,
+			BeamAmount: 1,
+			TargetID:   target.ID,
+			Orbit:      true,
+		})
+		return
+	}
+
 	thrust := true
 	turnLeft, turnRight := ai.calculateBomberNavigation(myShip, target)
-	beamDown := ai.shouldBeamDown(myShip, target)
 
-	ai.client.SendInput(thrust, turnLeft, turnRight, -1, beamDown, false, 1, 0)
+	ai.client.SendInput(thrust, turnLeft, turnRight, -1, false, false, 1, 0)
 }
 
 // calculateBomberNavigation determines the navigation controls for approaching a target planet.
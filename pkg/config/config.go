@@ -4,6 +4,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -35,6 +36,9 @@ type EnvironmentConfig struct {
 	MaxGoroutines         int           `env:"NETREK_MAX_GOROUTINES"`
 	ShutdownTimeout       time.Duration `env:"NETREK_SHUTDOWN_TIMEOUT"`
 	ResourceCheckInterval time.Duration `env:"NETREK_RESOURCE_CHECK_INTERVAL"`
+
+	// AdminPassword authorizes admin commands such as pausing the game; empty disables them
+	AdminPassword string `env:"NETREK_ADMIN_PASSWORD"`
 }
 
 // ValidationError represents a configuration validation error
@@ -73,6 +77,9 @@ func LoadConfigFromEnv() (*EnvironmentConfig, error) {
 		MaxGoroutines:         getEnvAsIntOrDefault("NETREK_MAX_GOROUTINES", 1000),
 		ShutdownTimeout:       getEnvAsDurationOrDefault("NETREK_SHUTDOWN_TIMEOUT", 30*time.Second),
 		ResourceCheckInterval: getEnvAsDurationOrDefault("NETREK_RESOURCE_CHECK_INTERVAL", 10*time.Second),
+
+		// Admin commands are disabled unless a password is set
+		AdminPassword: getEnvOrDefault("NETREK_ADMIN_PASSWORD", ""),
 	}
 
 	if err := validateEnvironmentConfig(config); err != nil {
@@ -342,15 +349,40 @@ func ApplyEnvironmentOverrides(gameConfig *GameConfig) error {
 // ShipTypeConfig allows defining custom ship types and stats in config
 // Keyed by name (e.g. "Scout", "Destroyer")
 type ShipTypeConfig struct {
-	Name         string  `json:"name"`
-	MaxHull      int     `json:"maxHull"`
-	MaxShields   int     `json:"maxShields"`
-	MaxFuel      int     `json:"maxFuel"`
-	Acceleration float64 `json:"acceleration"`
-	TurnRate     float64 `json:"turnRate"`
-	MaxSpeed     float64 `json:"maxSpeed"`
-	WeaponSlots  int     `json:"weaponSlots"`
-	MaxArmies    int     `json:"maxArmies"`
+	Name           string  `json:"name"`
+	MaxHull        int     `json:"maxHull"`
+	MaxShields     int     `json:"maxShields"`
+	MaxFuel        int     `json:"maxFuel"`
+	Acceleration   float64 `json:"acceleration"`
+	TurnRate       float64 `json:"turnRate"`
+	MaxSpeed       float64 `json:"maxSpeed"`
+	WeaponSlots    int     `json:"weaponSlots"`
+	MaxArmies      int     `json:"maxArmies"`
+	MaxPerTeam     int     `json:"maxPerTeam"`     // 0 means unlimited; a Starbase is always limited to one
+	MinKills       int     `json:"minKills"`       // Kills needed this session to fly the class; 0 means none, or 10 for a Starbase
+	WarpMultiplier float64 `json:"warpMultiplier"` // Speed and acceleration factor while warping; 0 means no warp drive
+	WarpFuelRate   float64 `json:"warpFuelRate"`   // Fuel burned per second while warping
+}
+
+// WeaponTypeConfig defines a weapon type in config, keyed by weapon name (e.g. "Torpedo")
+type WeaponTypeConfig struct {
+	Behavior    string  `json:"behavior"` // "torpedo", "phaser" or "plasma"
+	Damage      int     `json:"damage"`
+	Speed       float64 `json:"speed"`
+	Range       float64 `json:"range"`
+	Cooldown    float64 `json:"cooldown"` // Seconds between shots
+	FuelCost    int     `json:"fuelCost"`
+	TurnRate    float64 `json:"turnRate"`    // Plasma only: radians per second the torpedo can steer
+	SeekAngle   float64 `json:"seekAngle"`   // Plasma only: half-angle in radians of its target cone
+	BlastRadius float64 `json:"blastRadius"` // Plasma only: radius of the explosion
+}
+
+// WeaponsConfig defines weapon types and the weapons each ship class mounts
+type WeaponsConfig struct {
+	Types map[string]WeaponTypeConfig `json:"types"`
+	// Loadouts maps a ship class name to weapon names in slot order; a ship mounts
+	// no more than its weaponSlots
+	Loadouts map[string][]string `json:"loadouts"`
 }
 
 // GameConfig contains configuration for a Netrek game
@@ -363,6 +395,8 @@ type GameConfig struct {
 	NetworkConfig NetworkConfig             `json:"network"`
 	GameRules     GameRules                 `json:"gameRules"`
 	ShipTypes     map[string]ShipTypeConfig `json:"shipTypes"`
+	Weapons       WeaponsConfig             `json:"weapons"`
+	Seed          uint64                    `json:"seed"` // RNG seed; 0 picks one from the clock
 }
 
 // TeamConfig contains configuration for a team
@@ -402,12 +436,34 @@ type NetworkConfig struct {
 
 // GameRules contains game rules configuration
 type GameRules struct {
-	WinCondition   string `json:"winCondition"`
-	TimeLimit      int    `json:"timeLimit"`
-	MaxScore       int    `json:"maxScore"`
-	RespawnDelay   int    `json:"respawnDelay"`
-	FriendlyFire   bool   `json:"friendlyFire"`
-	StartingArmies int    `json:"startingArmies"`
+	WinCondition      string  `json:"winCondition"`
+	TimeLimit         int     `json:"timeLimit"`
+	MaxScore          int     `json:"maxScore"`
+	RespawnDelay      int     `json:"respawnDelay"`     // Seconds a destroyed ship waits before respawning
+	RespawnOnRequest  bool    `json:"respawnOnRequest"` // Wait for the player to ask before respawning
+	FriendlyFire      bool    `json:"friendlyFire"`
+	FriendlyFireScale float64 `json:"friendlyFireScale"` // Fraction of damage teammates take; 0 means full damage
+	TeamKillPenalty   int     `json:"teamKillPenalty"`   // Score deducted for destroying a teammate
+	StartingArmies    int     `json:"startingArmies"`
+	// ArmiesRequireKills limits the armies a ship may carry to ArmiesPerKill for each
+	// kill since its pilot last died, as in classic Netrek
+	ArmiesRequireKills bool `json:"armiesRequireKills"`
+	ArmiesPerKill      int  `json:"armiesPerKill"` // 0 uses the classic 2 armies per kill
+	// TeamBalanceMargin is how many more players one team may have than another before
+	// players are prompted to rebalance; 0 turns the prompts off
+	TeamBalanceMargin int `json:"teamBalanceMargin"`
+	// Tournament opens the game in a lobby where the match, and stat keeping, only
+	// starts once MinReadyPerTeam players on every team have marked ready
+	Tournament      bool `json:"tournament"`
+	MinReadyPerTeam int  `json:"minReadyPerTeam"` // 0 means 1
+	// IntermissionTime is how many seconds the results of a finished match are shown
+	// before the galaxy is reset for the next one; 0 leaves the finished game as it is
+	IntermissionTime int `json:"intermissionTime"`
+	// GalaxyRotation lists galaxy template names to play in turn, one per match
+	GalaxyRotation []string `json:"galaxyRotation,omitempty"`
+	// FlagCaptureLimit is how many enemy flags a team must capture to win when the
+	// WinCondition is "ctf"; 0 leaves the result to the time limit
+	FlagCaptureLimit int `json:"flagCaptureLimit"`
 }
 
 // LoadConfig loads a configuration from a file
@@ -427,22 +483,67 @@ func LoadConfig(path string) (*GameConfig, error) {
 		shipStats := make(map[string]entity.ShipStats)
 		for name, shipConfig := range config.ShipTypes {
 			shipStats[name] = entity.ShipStats{
-				MaxHull:      shipConfig.MaxHull,
-				MaxShields:   shipConfig.MaxShields,
-				MaxFuel:      shipConfig.MaxFuel,
-				Acceleration: shipConfig.Acceleration,
-				TurnRate:     shipConfig.TurnRate,
-				MaxSpeed:     shipConfig.MaxSpeed,
-				WeaponSlots:  shipConfig.WeaponSlots,
-				MaxArmies:    shipConfig.MaxArmies,
+				MaxHull:        shipConfig.MaxHull,
+				MaxShields:     shipConfig.MaxShields,
+				MaxFuel:        shipConfig.MaxFuel,
+				Acceleration:   shipConfig.Acceleration,
+				TurnRate:       shipConfig.TurnRate,
+				MaxSpeed:       shipConfig.MaxSpeed,
+				WeaponSlots:    shipConfig.WeaponSlots,
+				MaxArmies:      shipConfig.MaxArmies,
+				WarpMultiplier: shipConfig.WarpMultiplier,
+				WarpFuelRate:   shipConfig.WarpFuelRate,
 			}
 		}
 		entity.SetShipTypeStats(shipStats)
 	}
 
+	// Apply custom weapon definitions and loadouts to the entity system
+	if err := applyWeaponsConfig(config.Weapons); err != nil {
+		return nil, fmt.Errorf("invalid weapons config: %w", err)
+	}
+
 	return &config, nil
 }
 
+// applyWeaponsConfig validates the weapons section and injects it into the entity system.
+func applyWeaponsConfig(weapons WeaponsConfig) error {
+	if len(weapons.Types) > 0 {
+		weaponStats := make(map[string]entity.WeaponStats)
+		for name, weaponConfig := range weapons.Types {
+			switch weaponConfig.Behavior {
+			case entity.TorpedoBehavior, entity.PhaserBehavior, entity.PlasmaBehavior:
+			default:
+				return fmt.Errorf("weapon %q has unknown behavior %q", name, weaponConfig.Behavior)
+			}
+			weaponStats[name] = entity.WeaponStats{
+				Behavior:    weaponConfig.Behavior,
+				Damage:      weaponConfig.Damage,
+				Speed:       weaponConfig.Speed,
+				Range:       weaponConfig.Range,
+				Cooldown:    time.Duration(weaponConfig.Cooldown * float64(time.Second)),
+				FuelCost:    weaponConfig.FuelCost,
+				TurnRate:    weaponConfig.TurnRate,
+				SeekAngle:   weaponConfig.SeekAngle,
+				BlastRadius: weaponConfig.BlastRadius,
+			}
+		}
+		entity.SetWeaponTypeStats(weaponStats)
+	}
+
+	if len(weapons.Loadouts) > 0 {
+		for class, loadout := range weapons.Loadouts {
+			for _, name := range loadout {
+				if entity.NewWeapon(name, 0) == nil {
+					return fmt.Errorf("loadout for %q uses unknown weapon %q", class, name)
+				}
+			}
+		}
+		entity.SetShipLoadouts(weapons.Loadouts)
+	}
+	return nil
+}
+
 // SaveConfig saves a configuration to a file
 func SaveConfig(config *GameConfig, path string) error {
 	data, err := json.MarshalIndent(config, "", "  ")
@@ -468,6 +569,7 @@ func DefaultConfig() *GameConfig {
 		NetworkConfig: createDefaultNetworkConfig(),
 		GameRules:     createDefaultGameRules(),
 		ShipTypes:     createDefaultShipTypes(),
+		Weapons:       createDefaultWeapons(),
 	}
 }
 
@@ -538,12 +640,20 @@ func createDefaultNetworkConfig() NetworkConfig {
 // createDefaultGameRules creates the default game rules configuration for a new game.
 func createDefaultGameRules() GameRules {
 	return GameRules{
-		WinCondition:   "conquest",
-		TimeLimit:      1800,
-		MaxScore:       100,
-		RespawnDelay:   5,
-		FriendlyFire:   false,
-		StartingArmies: 0,
+		WinCondition:      "conquest",
+		TimeLimit:         1800,
+		MaxScore:          100,
+		RespawnDelay:      5,
+		FriendlyFire:      false,
+		FriendlyFireScale: 0.5,
+		TeamKillPenalty:   10,
+		StartingArmies:    0,
+
+		ArmiesRequireKills: false,
+		ArmiesPerKill:      2,
+		TeamBalanceMargin:  2,
+		IntermissionTime:   30,
+		FlagCaptureLimit:   3,
 	}
 }
 
@@ -551,26 +661,91 @@ func createDefaultGameRules() GameRules {
 func createDefaultShipTypes() map[string]ShipTypeConfig {
 	return map[string]ShipTypeConfig{
 		"Scout": {
-			Name:         "Scout",
-			MaxHull:      100,
-			MaxShields:   100,
-			MaxFuel:      1000,
-			Acceleration: 200,
-			TurnRate:     3.0,
-			MaxSpeed:     300,
-			WeaponSlots:  2,
-			MaxArmies:    2,
+			Name:           "Scout",
+			MaxHull:        100,
+			MaxShields:     100,
+			MaxFuel:        1000,
+			Acceleration:   200,
+			TurnRate:       3.0,
+			MaxSpeed:       300,
+			WeaponSlots:    2,
+			MaxArmies:      2,
+			WarpMultiplier: 2.0,
+			WarpFuelRate:   40,
 		},
 		"Destroyer": {
-			Name:         "Destroyer",
-			MaxHull:      150,
-			MaxShields:   150,
-			MaxFuel:      1200,
-			Acceleration: 150,
-			TurnRate:     2.5,
-			MaxSpeed:     250,
-			WeaponSlots:  3,
-			MaxArmies:    5,
+			Name:           "Destroyer",
+			MaxHull:        150,
+			MaxShields:     150,
+			MaxFuel:        1200,
+			Acceleration:   150,
+			TurnRate:       2.5,
+			MaxSpeed:       250,
+			WeaponSlots:    3,
+			MaxArmies:      5,
+			WarpMultiplier: 1.8,
+			WarpFuelRate:   45,
+		},
+		"Starbase": {
+			Name:         "Starbase",
+			MaxHull:      600,
+			MaxShields:   500,
+			MaxFuel:      5000,
+			Acceleration: 30,
+			TurnRate:     0.8,
+			MaxSpeed:     60,
+			WeaponSlots:  5,
+			MaxArmies:    25,
+			MaxPerTeam:   1,
+			MinKills:     10,
+		},
+	}
+}
+
+// createDefaultWeapons creates the default weapon types and ship loadouts for a new game.
+func createDefaultWeapons() WeaponsConfig {
+	return WeaponsConfig{
+		Types: map[string]WeaponTypeConfig{
+			"Torpedo": {
+				Behavior: entity.TorpedoBehavior,
+				Damage:   40,
+				Speed:    500,
+				Range:    2000,
+				Cooldown: 0.5,
+				FuelCost: 10,
+			},
+			"Phaser": {
+				Behavior: entity.PhaserBehavior,
+				Damage:   20,
+				Speed:    1000,
+				Range:    800,
+				Cooldown: 0.2,
+				FuelCost: 5,
+			},
+			"HeavyPhaser": {
+				Behavior: entity.PhaserBehavior,
+				Damage:   40,
+				Speed:    1000,
+				Range:    1200,
+				Cooldown: 0.3,
+				FuelCost: 10,
+			},
+			"Plasma": {
+				Behavior:    entity.PlasmaBehavior,
+				Damage:      100,
+				Speed:       300,
+				Range:       3000,
+				Cooldown:    3,
+				FuelCost:    30,
+				TurnRate:    1.5,
+				SeekAngle:   math.Pi / 4,
+				BlastRadius: 100,
+			},
+		},
+		Loadouts: map[string][]string{
+			"Scout":     {"Torpedo", "Phaser"},
+			"Destroyer": {"Torpedo", "Phaser", "Plasma"},
+			"Starbase":  {"Torpedo", "HeavyPhaser", "Plasma"},
 		},
 	}
 }
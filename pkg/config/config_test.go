@@ -84,6 +84,9 @@ func TestDefaultConfig(t *testing.T) {
 	if config.GameRules.FriendlyFire {
 		t.Error("Expected FriendlyFire to be false")
 	}
+	if config.GameRules.ArmiesRequireKills {
+		t.Error("Expected ArmiesRequireKills to be false, matching configs that omit it")
+	}
 }
 
 func TestLoadConfig_Success(t *testing.T) {
@@ -444,6 +447,62 @@ func TestDefaultConfig_PlanetConfigurations(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_Weapons(t *testing.T) {
+	jsonData := `{
+		"worldSize": 1000,
+		"teams": [{"name": "Red", "color": "#f00", "maxShips": 4, "startingShip": "Scout"}],
+		"weapons": {
+			"types": {
+				"Torpedo": {"behavior": "torpedo", "damage": 70, "speed": 400, "range": 1500, "cooldown": 1.5, "fuelCost": 12},
+				"Mine": {"behavior": "plasma", "damage": 200, "speed": 10, "range": 100, "cooldown": 10, "fuelCost": 50, "blastRadius": 150}
+			},
+			"loadouts": {"Destroyer": ["Mine", "Torpedo"]}
+		}
+	}`
+	path := filepath.Join(t.TempDir(), "weapons.json")
+	if err := os.WriteFile(path, []byte(jsonData), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer entity.SetWeaponTypeStats(nil)
+	defer entity.SetShipLoadouts(nil)
+
+	if _, err := LoadConfig(path); err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	torpedo := entity.NewTorpedo(1)
+	if torpedo.Damage != 70 || torpedo.Range != 1500 || torpedo.GetCooldown().Seconds() != 1.5 {
+		t.Errorf("expected configured torpedo stats, got %+v", torpedo)
+	}
+	ship := entity.NewShip(1, entity.Destroyer, 0, physics.Vector2D{})
+	if len(ship.Weapons) != 2 || ship.Weapons[0].GetName() != "Mine" {
+		t.Fatalf("expected the Destroyer loadout, got %d weapons", len(ship.Weapons))
+	}
+	if mine, ok := ship.Weapons[0].(*entity.Plasma); !ok || mine.BlastRadius != 150 {
+		t.Errorf("expected a plasma-behaviour mine with a 150 blast radius, got %+v", ship.Weapons[0])
+	}
+}
+
+func TestLoadConfig_WeaponsInvalid(t *testing.T) {
+	defer entity.SetWeaponTypeStats(nil)
+	defer entity.SetShipLoadouts(nil)
+
+	for name, weapons := range map[string]string{
+		"UnknownBehavior": `{"types": {"Laser": {"behavior": "laser"}}}`,
+		"UnknownWeapon":   `{"loadouts": {"Scout": ["Laser"]}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "weapons.json")
+			if err := os.WriteFile(path, []byte(`{"weapons": `+weapons+`}`), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); err == nil {
+				t.Error("expected an error for an invalid weapons section")
+			}
+		})
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
@@ -467,7 +526,7 @@ func TestLoadConfig_CustomShipTypes(t *testing.T) {
 		"teams": [{"name": "Red", "color": "#f00", "maxShips": 4, "startingShip": "Scout"}],
 		"planets": [],
 		"shipTypes": {
-			"Scout": {"name": "Scout", "maxHull": 999, "maxShields": 1, "maxFuel": 1, "acceleration": 1, "turnRate": 1, "maxSpeed": 1, "weaponSlots": 1, "maxArmies": 1}
+			"Scout": {"name": "Scout", "maxHull": 999, "maxShields": 1, "maxFuel": 1, "acceleration": 1, "turnRate": 1, "maxSpeed": 1, "weaponSlots": 1, "maxArmies": 1, "warpMultiplier": 3, "warpFuelRate": 7}
 		}
 	}`
 	f, err := os.CreateTemp("", "testconfig-*.json")
@@ -488,4 +547,7 @@ func TestLoadConfig_CustomShipTypes(t *testing.T) {
 	if ship.Stats.MaxHull != 999 {
 		t.Errorf("expected MaxHull 999, got %d", ship.Stats.MaxHull)
 	}
+	if ship.Stats.WarpMultiplier != 3 || ship.Stats.WarpFuelRate != 7 {
+		t.Errorf("expected warp 3x at 7 fuel/s, got %vx at %v", ship.Stats.WarpMultiplier, ship.Stats.WarpFuelRate)
+	}
 }
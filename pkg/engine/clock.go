@@ -0,0 +1,48 @@
+// pkg/engine/clock.go
+package engine
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock abstracts the time source that drives the game loop.
+// The simulation itself only ever advances in fixed TimeStep ticks; the clock
+// merely decides how many ticks are due when Advance is called.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock backed by the system wall clock.
+type SystemClock struct{}
+
+// Now returns the current wall-clock time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ManualClock is a Clock that only moves when told to.
+// It is intended for tests, replays and lockstep bots.
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock creates a manual clock starting at the given time
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the clock's current time
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by the given duration
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
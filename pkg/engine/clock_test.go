@@ -0,0 +1,107 @@
+// pkg/engine/clock_test.go
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+func TestGame_Advance_RunsFixedTicksFromClock(t *testing.T) {
+	game := NewGame(defaultConfig())
+	clock := NewManualClock(time.Unix(0, 0))
+	game.Clock = clock
+	game.Start()
+
+	// 10ms is less than one 1/60s tick, so nothing runs yet
+	clock.Advance(10 * time.Millisecond)
+	if ticks := game.Advance(); ticks != 0 {
+		t.Errorf("expected 0 ticks, got %d", ticks)
+	}
+
+	// The carried-over 10ms plus another 10ms makes one full tick
+	clock.Advance(10 * time.Millisecond)
+	if ticks := game.Advance(); ticks != 1 {
+		t.Errorf("expected 1 tick, got %d", ticks)
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	if ticks := game.Advance(); ticks != 3 {
+		t.Errorf("expected 3 ticks, got %d", ticks)
+	}
+	if game.CurrentTick != 4 {
+		t.Errorf("expected tick 4, got %d", game.CurrentTick)
+	}
+}
+
+func TestGame_Advance_CapsCatchUp(t *testing.T) {
+	game := NewGame(defaultConfig())
+	clock := NewManualClock(time.Unix(0, 0))
+	game.Clock = clock
+	game.Start()
+
+	clock.Advance(10 * time.Second)
+	ticks := game.Advance()
+	if maxTicks := int(maxCatchUpTime/game.TimeStep) + 1; ticks > maxTicks {
+		t.Errorf("expected at most %d catch-up ticks, got %d", maxTicks, ticks)
+	}
+}
+
+func TestGame_ElapsedTime_MeasuredInTicks(t *testing.T) {
+	game := NewGame(defaultConfig())
+	game.Start()
+	for i := 0; i < 30; i++ {
+		game.Update()
+	}
+	want := 30 * game.TimeStep
+	if diff := game.ElapsedTime - want; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("expected elapsed time %v, got %v", want, game.ElapsedTime)
+	}
+}
+
+// runScriptedGame plays a fixed sequence of inputs and returns the final ship positions.
+func runScriptedGame(seed uint64) []physics.Vector2D {
+	cfg := defaultConfig()
+	cfg.Seed = seed
+	cfg.GameRules.TimeLimit = 0
+	game := NewGame(cfg)
+	game.Start()
+
+	var shipIDs []uint64
+	for i, name := range []string{"A", "B", "C"} {
+		pid, _ := game.AddPlayer(name, i%2)
+		ship := game.Ships[game.Teams[i%2].Players[pid].ShipID]
+		ship.Thrusting = true
+		ship.TurningCW = i == 1
+		shipIDs = append(shipIDs, uint64(ship.ID))
+	}
+
+	for tick := 0; tick < 120; tick++ {
+		if tick%20 == 0 {
+			for _, ship := range game.shipsInOrder() {
+				_ = game.FireWeapon(ship.ID, 0)
+			}
+		}
+		game.Update()
+	}
+
+	positions := make([]physics.Vector2D, 0, len(shipIDs))
+	for _, ship := range game.shipsInOrder() {
+		positions = append(positions, ship.Position)
+	}
+	return positions
+}
+
+func TestGame_DeterministicWithSameSeed(t *testing.T) {
+	first := runScriptedGame(42)
+	second := runScriptedGame(42)
+	if len(first) != len(second) {
+		t.Fatalf("ship count differs: %d vs %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("ship %d diverged: %v vs %v", i, first[i], second[i])
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// pkg/engine/ctf.go
+package engine
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+const (
+	// flagCaptureRange is how far beyond its homeworld's surface a carrier must bring an
+	// enemy flag to capture it
+	flagCaptureRange = 100.0
+	// flagCaptureScore is the score a player earns for capturing an enemy flag
+	flagCaptureScore = 100
+)
+
+// FlagState represents a snapshot of a capture the flag flag
+type FlagState struct {
+	ID        entity.ID
+	TeamID    int
+	Position  physics.Vector2D
+	CarrierID entity.ID // Ship carrying the flag, 0 if none
+	AtHome    bool
+}
+
+// initFlags places a flag on every team homeworld when capture the flag is played.
+func (g *Game) initFlags() {
+	g.Flags = make(map[entity.ID]*entity.Flag)
+	if g.Config.GameRules.WinCondition != "ctf" {
+		return
+	}
+
+	for _, planet := range g.planetsInOrder() {
+		if planet.Type != entity.Homeworld || planet.TeamID < 0 {
+			continue
+		}
+		flag := entity.NewFlag(entity.GenerateID(), planet.TeamID, planet.ID, planet.Position)
+		g.Flags[flag.ID] = flag
+	}
+}
+
+// updateFlags moves carried flags with their carriers and resolves ships touching flags:
+// an enemy ship picks a flag up, a ship of the flag's own team returns a dropped flag
+// home, and a carrier reaching its own homeworld captures the flag it carries. Flags
+// are dropped where their carrier is destroyed or leaves the game. ships is every ship
+// in ID order.
+// Note: Called from within locked context in Update()
+func (g *Game) updateFlags(ships []*entity.Ship) {
+	if g.Status != GameStatusActive {
+		return
+	}
+
+	for _, flag := range g.flagsInOrder() {
+		if flag.CarrierID != 0 {
+			g.updateCarriedFlag(flag)
+			continue
+		}
+		g.checkFlagTouched(flag, ships)
+	}
+}
+
+// updateCarriedFlag keeps a flag with its carrier, dropping it if the carrier is gone
+// and capturing it once the carrier reaches a homeworld its team holds. A team that has
+// lost its homeworld has nowhere to capture.
+func (g *Game) updateCarriedFlag(flag *entity.Flag) {
+	carrier, ok := g.Ships[flag.CarrierID]
+	if !ok || !carrier.Active {
+		g.publishFlagEvent(event.FlagDropped, flag, carrier)
+		flag.Drop(carrier)
+		return
+	}
+
+	flag.Position = carrier.Position
+	for _, planet := range g.planetsInOrder() {
+		if planet.Type != entity.Homeworld || planet.TeamID != carrier.TeamID {
+			continue
+		}
+		if carrier.Position.Distance(planet.Position) <= planet.Collider.Radius+flagCaptureRange {
+			g.captureFlag(flag, carrier)
+			return
+		}
+	}
+}
+
+// checkFlagTouched lets the first of ships touching a flag that is not being carried
+// pick it up, or return it home if the ship is on the flag's team.
+func (g *Game) checkFlagTouched(flag *entity.Flag, ships []*entity.Ship) {
+	for _, ship := range ships {
+		if !ship.Active || !flag.Touches(ship) {
+			continue
+		}
+
+		if ship.TeamID == flag.TeamID {
+			if flag.AtHome() {
+				continue
+			}
+			flag.Return(nil)
+			g.publishFlagEvent(event.FlagReturned, flag, ship)
+			return
+		}
+
+		if ship.CarryingFlag == 0 {
+			flag.PickUp(ship)
+			g.publishFlagEvent(event.FlagPickedUp, flag, ship)
+			return
+		}
+	}
+}
+
+// captureFlag scores a flag capture for the carrier's team and sends the flag home.
+func (g *Game) captureFlag(flag *entity.Flag, carrier *entity.Ship) {
+	flag.Return(carrier)
+	if team, ok := g.Teams[carrier.TeamID]; ok {
+		team.FlagCaptures++
+	}
+	if player, ok := g.findPlayerByShipID(carrier.ID); ok {
+		player.Score += flagCaptureScore
+	}
+	g.publishFlagEvent(event.FlagCaptured, flag, carrier)
+}
+
+// publishFlagEvent publishes a flag event for the ship involved, which may be nil.
+func (g *Game) publishFlagEvent(eventType event.Type, flag *entity.Flag, ship *entity.Ship) {
+	var shipID entity.ID
+	shipTeamID := -1
+	if ship != nil {
+		shipID, shipTeamID = ship.ID, ship.TeamID
+	}
+	g.EventBus.Publish(event.NewFlagEvent(
+		eventType,
+		g,
+		uint64(flag.ID),
+		flag.TeamID,
+		uint64(shipID),
+		shipTeamID,
+	))
+}
+
+// checkCTFWin checks if any team has captured GameRules.FlagCaptureLimit flags.
+func (g *Game) checkCTFWin() {
+	limit := g.Config.GameRules.FlagCaptureLimit
+	if limit <= 0 {
+		return // No capture limit configured, the time limit decides
+	}
+	for _, team := range g.teamsInOrder() {
+		if team.FlagCaptures >= limit {
+			g.endGameInternal()
+			return
+		}
+	}
+}
+
+// flagsInOrder returns all flags sorted by ID.
+func (g *Game) flagsInOrder() []*entity.Flag {
+	flags := make([]*entity.Flag, 0, len(g.Flags))
+	for _, flag := range g.Flags {
+		flags = append(flags, flag)
+	}
+	slices.SortFunc(flags, func(a, b *entity.Flag) int { return cmp.Compare(a.ID, b.ID) })
+	return flags
+}
+
+// getFlagStates creates a snapshot of the flags, or nil when capture the flag is not played.
+func (g *Game) getFlagStates() map[entity.ID]FlagState {
+	if len(g.Flags) == 0 {
+		return nil
+	}
+
+	states := make(map[entity.ID]FlagState, len(g.Flags))
+	for id, flag := range g.Flags {
+		states[id] = FlagState{
+			ID:        id,
+			TeamID:    flag.TeamID,
+			Position:  flag.Position,
+			CarrierID: flag.CarrierID,
+			AtHome:    flag.AtHome(),
+		}
+	}
+	return states
+}
@@ -0,0 +1,180 @@
+// Package engine provides unit tests for ctf.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// newCTFGame starts a capture the flag game with ungarrisoned homeworlds Earth and Mars
+// far apart, won by two captures.
+func newCTFGame() *Game {
+	cfg := wideConfig()
+	cfg.Planets[0].InitialArmies = 0
+	cfg.Planets = append(cfg.Planets, config.PlanetConfig{
+		Name: "Mars", X: 4000, Y: 0, Type: entity.Homeworld, HomeWorld: true, TeamID: 1,
+	})
+	cfg.GameRules.WinCondition = "ctf"
+	cfg.GameRules.FlagCaptureLimit = 2
+	game := NewGame(cfg)
+	game.Start()
+	return game
+}
+
+// teamFlag returns the flag belonging to a team.
+func teamFlag(game *Game, teamID int) *entity.Flag {
+	for _, flag := range game.flagsInOrder() {
+		if flag.TeamID == teamID {
+			return flag
+		}
+	}
+	return nil
+}
+
+func TestGame_CTF_FlagsOnlyInCTF(t *testing.T) {
+	if game := NewGame(defaultConfig()); len(game.Flags) != 0 || game.GetGameState().Flags != nil {
+		t.Error("flags should only be placed when playing capture the flag")
+	}
+
+	game := newCTFGame()
+	flags := game.GetGameState().Flags
+	if len(flags) != 2 {
+		t.Fatalf("expected a flag on each homeworld, got %d", len(flags))
+	}
+	for _, flag := range flags {
+		home := planetNamed(game, "Earth")
+		if flag.TeamID == 1 {
+			home = planetNamed(game, "Mars")
+		}
+		if !flag.AtHome || flag.Position != home.Position {
+			t.Errorf("flag %+v should rest on its homeworld", flag)
+		}
+	}
+}
+
+func TestGame_CTF_PickUpAndCapture(t *testing.T) {
+	game := newCTFGame()
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 2000, Y: 2000}, 300)
+	red := ships[0]
+	var events []event.Type
+	for _, eventType := range []event.Type{event.FlagPickedUp, event.FlagCaptured} {
+		game.EventBus.Subscribe(eventType, func(e event.Event) {
+			events = append(events, e.GetType())
+		})
+	}
+
+	blueFlag := teamFlag(game, 1)
+	moveShip(red, planetNamed(game, "Mars").Position.Add(physics.Vector2D{X: 70}))
+	game.Update()
+	if blueFlag.CarrierID != red.ID || red.CarryingFlag != blueFlag.ID {
+		t.Fatal("an enemy ship touching a flag should pick it up")
+	}
+
+	red.Thrusting = true
+	for i := 0; i < 600; i++ {
+		red.Update(game.TimeStep)
+	}
+	if speed := red.Velocity.Length(); speed > red.Stats.MaxSpeed*0.75 {
+		t.Errorf("flag carrier should be slowed, moving at %.0f of %.0f", speed, red.Stats.MaxSpeed)
+	}
+	red.Thrusting = false
+
+	moveShip(red, physics.Vector2D{X: 1000, Y: 1000})
+	game.Update()
+	if blueFlag.Position != red.Position {
+		t.Error("a carried flag should move with its carrier")
+	}
+
+	moveShip(red, planetNamed(game, "Earth").Position.Add(physics.Vector2D{X: 120}))
+	game.Update()
+	if !blueFlag.AtHome() || red.CarryingFlag != 0 {
+		t.Error("a captured flag should be sent home")
+	}
+	if game.Teams[0].FlagCaptures != 1 || game.GetGameState().Teams[0].FlagCaptures != 1 {
+		t.Errorf("expected 1 capture for team 0, got %d", game.Teams[0].FlagCaptures)
+	}
+	if len(events) != 2 || events[0] != event.FlagPickedUp || events[1] != event.FlagCaptured {
+		t.Errorf("expected picked up then captured events, got %v", events)
+	}
+}
+
+func TestGame_CTF_NoCaptureWithoutHomeworld(t *testing.T) {
+	game := newCTFGame()
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 2000, Y: 2000}, 300)
+	red := ships[0]
+	colony := entity.NewPlanet(entity.GenerateID(), "Colony", physics.Vector2D{X: 0, Y: -3000}, entity.Industrial)
+	colony.TeamID = 0
+	game.Planets[colony.ID] = colony
+
+	blueFlag := teamFlag(game, 1)
+	moveShip(red, planetNamed(game, "Mars").Position.Add(physics.Vector2D{X: 70}))
+	game.Update()
+	planetNamed(game, "Earth").TeamID = 1
+
+	for _, planet := range []*entity.Planet{colony, planetNamed(game, "Earth")} {
+		moveShip(red, planet.Position.Add(physics.Vector2D{X: 120}))
+		game.Update()
+		if blueFlag.CarrierID != red.ID || game.Teams[0].FlagCaptures != 0 {
+			t.Errorf("a team that lost its homeworld should not capture at %s", planet.Name)
+		}
+	}
+}
+
+func TestGame_CTF_DropAndReturn(t *testing.T) {
+	game := newCTFGame()
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 2000, Y: 2000}, 300)
+	red, blue := ships[0], ships[1]
+	var events []event.Type
+	for _, eventType := range []event.Type{event.FlagDropped, event.FlagReturned} {
+		game.EventBus.Subscribe(eventType, func(e event.Event) {
+			events = append(events, e.GetType())
+		})
+	}
+
+	blueFlag := teamFlag(game, 1)
+	moveShip(red, planetNamed(game, "Mars").Position.Add(physics.Vector2D{X: 70}))
+	game.Update()
+	moveShip(red, physics.Vector2D{X: 3000, Y: 1000})
+	game.Update()
+
+	game.handleShipDestruction(red, blue.ID, blue.TeamID)
+	game.Update()
+	if blueFlag.CarrierID != 0 || blueFlag.AtHome() || blueFlag.Position != red.Position {
+		t.Fatal("a flag should be dropped where its carrier is destroyed")
+	}
+
+	moveShip(blue, blueFlag.Position)
+	game.Update()
+	if !blueFlag.AtHome() {
+		t.Error("a ship touching its own team's dropped flag should return it home")
+	}
+	if len(events) != 2 || events[0] != event.FlagDropped || events[1] != event.FlagReturned {
+		t.Errorf("expected dropped then returned events, got %v", events)
+	}
+}
+
+func TestGame_CTF_CaptureLimitWins(t *testing.T) {
+	game := newCTFGame()
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 2000, Y: 2000}, 300)
+	red := ships[0]
+
+	for i := 0; i < 2; i++ {
+		moveShip(red, planetNamed(game, "Mars").Position.Add(physics.Vector2D{X: 70}))
+		game.Update()
+		moveShip(red, planetNamed(game, "Earth").Position.Add(physics.Vector2D{X: 120}))
+		game.Update()
+	}
+	game.Update()
+
+	if game.Status != GameStatusEnded || game.WinningTeam != 0 {
+		t.Errorf("reaching the capture limit should win, status %v winner %d", game.Status, game.WinningTeam)
+	}
+}
@@ -0,0 +1,106 @@
+// Package engine provides unit tests for detonate.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// torpedoAt places an active torpedo fired by the player's ship at a position.
+func torpedoAt(game *Game, player *Player, position physics.Vector2D) *entity.Projectile {
+	proj := torpedoFrom(player)
+	proj.Position = position
+	proj.Collider.Center = position
+	game.Projectiles[proj.ID] = proj
+	return proj
+}
+
+func TestGame_DetonateEnemyTorpedoes(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 2000}, 1000)
+	defender, attacker := shipPlayer(game, ships[0]), shipPlayer(game, ships[1])
+	ship := ships[0]
+	hull, fuel := ship.Hull, ship.Fuel
+
+	near := torpedoAt(game, attacker, physics.Vector2D{X: ship.Position.X + 200, Y: ship.Position.Y})
+	far := torpedoAt(game, attacker, physics.Vector2D{X: ship.Position.X + 500, Y: ship.Position.Y})
+	own := torpedoAt(game, defender, physics.Vector2D{X: ship.Position.X + 100, Y: ship.Position.Y})
+
+	count, err := game.DetonateEnemyTorpedoes(ship.ID)
+	if err != nil || count != 1 {
+		t.Fatalf("DetonateEnemyTorpedoes() = %d, %v; want 1 torpedo", count, err)
+	}
+	if near.Active || !far.Active || !own.Active {
+		t.Errorf("only the nearby enemy torpedo should detonate: near=%v far=%v own=%v", near.Active, far.Active, own.Active)
+	}
+	if ship.Fuel != fuel-detonateFuelCost {
+		t.Errorf("fuel = %d, want %d", ship.Fuel, fuel-detonateFuelCost)
+	}
+	if ship.Hull != hull {
+		t.Error("a torpedo detonated outside its blast radius should not damage the ship")
+	}
+
+	ship.Fuel = detonateFuelCost - 1
+	if _, err := game.DetonateEnemyTorpedoes(ship.ID); err == nil {
+		t.Error("expected error detonating without enough fuel")
+	}
+}
+
+func TestGame_DetonateEnemyTorpedoes_CloseBlastHurts(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 2000}, 1000)
+	ship, attacker := ships[0], shipPlayer(game, ships[1])
+	hull := ship.Hull
+
+	torpedoAt(game, attacker, physics.Vector2D{X: ship.Position.X + 40, Y: ship.Position.Y})
+
+	if _, err := game.DetonateEnemyTorpedoes(ship.ID); err != nil {
+		t.Fatalf("DetonateEnemyTorpedoes failed: %v", err)
+	}
+	if ship.Hull >= hull {
+		t.Error("detonating a torpedo this close should damage the ship")
+	}
+}
+
+func TestGame_DetonateEnemyTorpedoes_FiredThisTick(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 2000}, 250)
+	ship, attacker := ships[0], ships[1]
+
+	if err := game.FireWeaponAt(attacker.ID, 0, ship.ID); err != nil {
+		t.Fatalf("FireWeaponAt failed: %v", err)
+	}
+
+	count, err := game.DetonateEnemyTorpedoes(ship.ID)
+	if err != nil || count != 1 {
+		t.Errorf("DetonateEnemyTorpedoes() = %d, %v; want the torpedo fired before the tick", count, err)
+	}
+}
+
+func TestGame_DetonateOwnTorpedoes(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 2000}, 1000)
+	shooter, target, enemy := shipPlayer(game, ships[0]), shipPlayer(game, ships[1]), ships[1]
+	hull := enemy.Hull
+
+	first := torpedoAt(game, shooter, physics.Vector2D{X: enemy.Position.X - 30, Y: enemy.Position.Y})
+	second := torpedoAt(game, shooter, physics.Vector2D{X: 0, Y: -2000})
+	theirs := torpedoAt(game, target, physics.Vector2D{X: 0, Y: -2100})
+
+	count, err := game.DetonateOwnTorpedoes(shooter.ShipID)
+	if err != nil || count != 2 {
+		t.Fatalf("DetonateOwnTorpedoes() = %d, %v; want 2 torpedoes", count, err)
+	}
+	if first.Active || second.Active || !theirs.Active {
+		t.Error("only the ship's own torpedoes should detonate")
+	}
+	if enemy.Hull >= hull {
+		t.Error("a torpedo detonated next to an enemy should damage it")
+	}
+}
@@ -0,0 +1,89 @@
+// pkg/engine/economy.go
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+const (
+	// planetDefenseRange is how far beyond a planet's surface its defenses engage enemy ships
+	planetDefenseRange = 600.0
+	// planetKillScore is the team score awarded when a team's planet destroys an enemy ship
+	planetKillScore = 10
+)
+
+// teamEconomy holds the resources and army production of the planets a team owns
+type teamEconomy struct {
+	resources  int
+	production int
+}
+
+// getTeamEconomies totals the resources and production of each team's planets.
+func (g *Game) getTeamEconomies() map[int]teamEconomy {
+	economies := make(map[int]teamEconomy)
+	for _, planet := range g.Planets {
+		if planet.TeamID < 0 {
+			continue
+		}
+		economy := economies[planet.TeamID]
+		economy.resources += planet.Resources
+		economy.production += planet.Production
+		economies[planet.TeamID] = economy
+	}
+	return economies
+}
+
+// updatePlanetDefenses has every defended planet fire at the nearest enemy ship in range,
+// including ships orbiting it. Each shot is owned by the planet, so hits and kills are
+// attributed to it like any other projectile. ships is every ship in ID order.
+// Note: Called from within locked context in Update()
+func (g *Game) updatePlanetDefenses(ships []*entity.Ship) {
+	for _, planet := range g.planetsInOrder() {
+		if planet.Defense == nil || planet.TeamID < 0 {
+			continue
+		}
+		target, ok := g.findPlanetDefenseTarget(planet, ships)
+		if !ok {
+			continue
+		}
+		if proj := planet.FireDefense(target.Position); proj != nil {
+			g.registerAndPublishProjectile(proj, planet.ID)
+		}
+	}
+}
+
+// findPlanetDefenseTarget returns the nearest of ships that is an enemy within
+// planetDefenseRange of a planet's surface. Cloaked ships cannot be targeted.
+func (g *Game) findPlanetDefenseTarget(planet *entity.Planet, ships []*entity.Ship) (*entity.Ship, bool) {
+	var nearest *entity.Ship
+	nearestGap := math.Inf(1)
+	for _, ship := range ships {
+		if !ship.Active || ship.Cloaked || ship.TeamID == planet.TeamID {
+			continue
+		}
+		gap := ship.Position.Distance(planet.Position) - planet.Collider.Radius
+		if gap <= planetDefenseRange && gap < nearestGap {
+			nearest, nearestGap = ship, gap
+		}
+	}
+	return nearest, nearest != nil
+}
+
+// creditPlanetKill awards a planet's team the score for an enemy ship its defenses
+// destroyed, since no player earned the kill, and publishes a TeamScoreChanged event.
+// Note: Called from within locked context in Update()
+func (g *Game) creditPlanetKill(teamID int) {
+	team, ok := g.Teams[teamID]
+	if !ok {
+		return
+	}
+	team.Score += planetKillScore
+
+	g.EventBus.Publish(&event.BaseEvent{
+		EventType: event.TeamScoreChanged,
+		Source:    team,
+	})
+}
@@ -0,0 +1,154 @@
+// Package engine provides unit tests for economy.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// newEconomyGame creates a game with the team 0 homeworld, a team 1 military planet
+// and a team 0 agricultural planet.
+func newEconomyGame() *Game {
+	cfg := wideConfig()
+	cfg.Planets = append(cfg.Planets,
+		config.PlanetConfig{Name: "Fort", X: 3000, Y: 0, Type: entity.Military, TeamID: 1, InitialArmies: 20},
+		config.PlanetConfig{Name: "Farm", X: -3000, Y: 0, Type: entity.Agricultural, TeamID: 0, InitialArmies: 5},
+	)
+	game := NewGame(cfg)
+
+	// Only homeworlds start owned, so hand the other planets to their teams
+	planetNamed(game, "Fort").TeamID = 1
+	planetNamed(game, "Farm").TeamID = 0
+	return game
+}
+
+// planetNamed returns the planet with the given name.
+func planetNamed(game *Game, name string) *entity.Planet {
+	for _, planet := range game.planetsInOrder() {
+		if planet.Name == name {
+			return planet
+		}
+	}
+	return nil
+}
+
+func TestGame_TeamStates_Economy(t *testing.T) {
+	game := newEconomyGame()
+	state := game.GetGameState()
+
+	earth, farm, fort := planetNamed(game, "Earth"), planetNamed(game, "Farm"), planetNamed(game, "Fort")
+	red, blue := state.Teams[0], state.Teams[1]
+	if red.Resources != earth.Resources+farm.Resources || red.Production != earth.Production+farm.Production {
+		t.Errorf("team 0 economy = %d resources, %d production", red.Resources, red.Production)
+	}
+	if blue.Resources != fort.Resources || blue.Production != fort.Production {
+		t.Errorf("team 1 economy = %d resources, %d production", blue.Resources, blue.Production)
+	}
+}
+
+func TestGame_MilitaryPlanet_FiresAtEnemies(t *testing.T) {
+	game := newEconomyGame()
+	ships := addShips(t, game, 0, 1)
+	enemy, friendly := ships[0], ships[1]
+	fort := planetNamed(game, "Fort")
+	moveShip(enemy, physics.Vector2D{X: 0, Y: 3000})
+	moveShip(friendly, physics.Vector2D{X: fort.Position.X, Y: 200})
+
+	game.updatePlanetDefenses(ships)
+	if len(game.Projectiles) != 0 {
+		t.Fatal("a planet should not fire with no enemy in range")
+	}
+
+	enemy.Position = physics.Vector2D{X: fort.Position.X - 300, Y: 0}
+	enemy.Cloaked = true
+	game.updatePlanetDefenses(ships)
+	if len(game.Projectiles) != 0 {
+		t.Fatal("a planet should not fire at a cloaked ship")
+	}
+
+	enemy.Cloaked = false
+	game.updatePlanetDefenses(ships)
+	if len(game.Projectiles) != 1 {
+		t.Fatalf("expected one defensive shot, got %d", len(game.Projectiles))
+	}
+	for _, proj := range game.Projectiles {
+		if proj.OwnerID != fort.ID || proj.Velocity.X >= 0 {
+			t.Errorf("expected a shot from the planet towards the enemy, got %+v", proj)
+		}
+	}
+
+	hull := enemy.Hull + enemy.Shields
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+	if enemy.Hull+enemy.Shields >= hull {
+		t.Error("the planet's defensive fire should damage the enemy ship")
+	}
+}
+
+func TestGame_PlanetDefense_CreditsKill(t *testing.T) {
+	game := newEconomyGame()
+	ships := addShips(t, game, 0, 1)
+	enemy := ships[0]
+	fort := planetNamed(game, "Fort")
+	moveShip(enemy, physics.Vector2D{X: fort.Position.X - 300, Y: 0})
+	moveShip(ships[1], physics.Vector2D{X: 0, Y: 3000})
+	enemy.Hull, enemy.Shields = 1, 0
+
+	var scoreEvents int
+	game.EventBus.Subscribe(event.TeamScoreChanged, func(e event.Event) {
+		scoreEvents++
+	})
+	destroyed := false
+	game.EventBus.Subscribe(event.ShipDestroyed, func(e event.Event) {
+		destroyed = true
+	})
+
+	for i := 0; i < 60 && enemy.Active; i++ {
+		game.Update()
+	}
+
+	if enemy.Active || !destroyed {
+		t.Fatal("the planet's defensive fire should destroy the enemy ship")
+	}
+	if game.Teams[1].Score != planetKillScore || scoreEvents != 1 {
+		t.Errorf("the planet's team should be credited with the kill: score %d, events %d", game.Teams[1].Score, scoreEvents)
+	}
+	if player, _ := game.findPlayerByShipID(enemy.ID); player.Deaths != 1 {
+		t.Errorf("victim deaths = %d, want 1", player.Deaths)
+	}
+}
+
+func TestGame_Orbit_RepairsOnlyAtShipyards(t *testing.T) {
+	game := newEconomyGame()
+	ships := addShips(t, game, 0, 1)
+	ship := ships[0]
+	farm := planetNamed(game, "Farm")
+	moveShip(ship, physics.Vector2D{X: farm.Position.X + farm.Collider.Radius + 50, Y: 0})
+	moveShip(ships[1], physics.Vector2D{X: 0, Y: 3000})
+	ship.Hull = ship.Stats.MaxHull / 2
+	ship.Fuel = 100
+
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	if ship.Fuel <= 100 {
+		t.Error("any friendly planet should refuel an orbiting ship")
+	}
+	if ship.Hull != ship.Stats.MaxHull/2 {
+		t.Error("a planet without a shipyard should not repair")
+	}
+
+	player, _ := game.findPlayerByShipID(ship.ID)
+	if err := game.RequestShipClass(player.ID, entity.Destroyer); err == nil {
+		t.Error("expected class changes to be refused away from a shipyard")
+	}
+}
@@ -0,0 +1,71 @@
+// pkg/engine/explosion.go
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// explosionDuration is how long, in seconds, a ship explosion stays in the game state
+const explosionDuration = 0.5
+
+// ExplosionState represents a ship explosion recent enough for clients to draw it
+type ExplosionState struct {
+	ShipID   entity.ID // Ship that exploded
+	TeamID   int
+	Position physics.Vector2D
+	Radius   float64
+	Tick     uint64 // Tick the ship exploded on
+}
+
+// explodeShip blows up a destroyed ship, dealing its explosion damage to every ship
+// within the blast radius, falling off linearly from full damage at the centre to none
+// at the edge. The blast counts as the killer's attack, so ships it destroys are
+// credited to the killer and friendly fire rules apply as for the killer's own weapons.
+// Ships destroyed by the blast explode in turn.
+// Note: Called from within locked context
+func (g *Game) explodeShip(ship *entity.Ship, killerID entity.ID, killerTeamID int) {
+	radius, damage := ship.Explosion()
+	g.explosions = append(g.explosions, ExplosionState{
+		ShipID:   ship.ID,
+		TeamID:   ship.TeamID,
+		Position: ship.Position,
+		Radius:   radius,
+		Tick:     g.CurrentTick,
+	})
+
+	for _, other := range g.shipsInOrder() {
+		if !other.Active || !g.canAttackShip(other, killerID, killerTeamID) {
+			continue
+		}
+		distance := math.Max(0, other.Position.Distance(ship.Position)-other.Collider.Radius)
+		if distance >= radius {
+			continue
+		}
+		falloff := int(math.Round(float64(damage) * (1 - distance/radius)))
+		g.applyWeaponDamage(other, falloff, ship.ID, killerID, killerTeamID)
+	}
+}
+
+// expireExplosions drops explosions that have been shown for explosionDuration.
+// Note: Called from within locked context in Update()
+func (g *Game) expireExplosions() {
+	lifetime := g.secondsToTicks(explosionDuration)
+	kept := g.explosions[:0]
+	for _, explosion := range g.explosions {
+		if g.CurrentTick-explosion.Tick < lifetime {
+			kept = append(kept, explosion)
+		}
+	}
+	g.explosions = kept
+}
+
+// getExplosions creates a snapshot of the explosions still being drawn.
+func (g *Game) getExplosions() []ExplosionState {
+	if len(g.explosions) == 0 {
+		return nil
+	}
+	return append([]ExplosionState(nil), g.explosions...)
+}
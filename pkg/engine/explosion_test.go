@@ -0,0 +1,89 @@
+// Package engine provides unit tests for explosion.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+func TestGame_ShipExplosion_ChainsKillsToAttacker(t *testing.T) {
+	// An attacker on team 1 and a victim, a bystander beside it and a distant wingman on team 0
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0, 0, 0)
+	attackerShip, victimShip, bystanderShip, wingmanShip := ships[0], ships[1], ships[2], ships[3]
+	moveShip(attackerShip, physics.Vector2D{X: 2000, Y: 3000})
+	moveShip(victimShip, physics.Vector2D{X: 3000, Y: 3000})
+	moveShip(bystanderShip, physics.Vector2D{X: 3060, Y: 3000})
+	moveShip(wingmanShip, physics.Vector2D{X: 4000, Y: 3000})
+	attacker, bystander := shipPlayer(game, attackerShip), shipPlayer(game, bystanderShip)
+
+	victimShip.Hull, victimShip.Shields = 1, 0
+	bystanderShip.Hull, bystanderShip.Shields = 1, 0
+	wingmanHull := wingmanShip.Hull + wingmanShip.Shields
+
+	game.applyWeaponDamage(victimShip, 10, attackerShip.ID, attackerShip.ID, attackerShip.TeamID)
+
+	if victimShip.Active || bystanderShip.Active {
+		t.Fatal("the explosion should destroy the damaged ship beside the victim")
+	}
+	if attacker.Kills != 2 {
+		t.Errorf("attacker kills = %d, want 2 including the explosion kill", attacker.Kills)
+	}
+	if bystander.Deaths != 1 {
+		t.Errorf("bystander deaths = %d, want 1", bystander.Deaths)
+	}
+	if wingmanShip.Hull+wingmanShip.Shields != wingmanHull {
+		t.Error("a ship outside the blast radius should not be damaged")
+	}
+
+	explosions := game.GetGameState().Explosions
+	if len(explosions) != 2 {
+		t.Fatalf("expected 2 explosions in the game state, got %d", len(explosions))
+	}
+	if explosions[0].ShipID != victimShip.ID || explosions[0].Position != victimShip.Position {
+		t.Errorf("expected the victim's explosion first, got %+v", explosions[0])
+	}
+
+	game.CurrentTick += game.secondsToTicks(explosionDuration)
+	game.expireExplosions()
+	if len(game.GetGameState().Explosions) != 0 {
+		t.Error("explosions should expire after explosionDuration")
+	}
+}
+
+func TestGame_ShipExplosion_SparesAttackerTeam(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0)
+	attackerShip, victimShip := ships[0], ships[1]
+	moveShip(attackerShip, physics.Vector2D{X: 2940, Y: 3000})
+	moveShip(victimShip, physics.Vector2D{X: 3000, Y: 3000})
+	hull := attackerShip.Hull + attackerShip.Shields
+	victimShip.Hull, victimShip.Shields = 1, 0
+
+	game.applyWeaponDamage(victimShip, 10, attackerShip.ID, attackerShip.ID, attackerShip.TeamID)
+
+	if attackerShip.Hull+attackerShip.Shields != hull {
+		t.Error("a ship's explosion should not damage the attacker credited with it")
+	}
+}
+
+func TestGame_ShipExplosion_StarbaseBlastIsLarger(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0)
+	attackerShip, victimShip := ships[0], ships[1]
+	moveShip(attackerShip, physics.Vector2D{X: 2000, Y: 3000})
+	moveShip(victimShip, physics.Vector2D{X: 3000, Y: 3000})
+	radius, _ := victimShip.Explosion()
+
+	base := entity.NewShip(victimShip.ID, entity.Starbase, 0, victimShip.Position)
+	game.Ships[base.ID] = base
+	base.Hull, base.Shields = 1, 0
+	game.applyWeaponDamage(base, 10, attackerShip.ID, attackerShip.ID, attackerShip.TeamID)
+
+	explosions := game.GetGameState().Explosions
+	if len(explosions) == 0 || explosions[0].Radius <= radius {
+		t.Errorf("a starbase should explode with a larger blast than a %s", victimShip.Class)
+	}
+}
@@ -0,0 +1,137 @@
+// Package engine provides unit tests for friendly fire rules
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// newFriendlyFireTestGame creates a game with two team 0 players and one team 1 player.
+func newFriendlyFireTestGame(t *testing.T, friendlyFire bool) (*Game, *Player, *Player, *Player) {
+	cfg := defaultConfig()
+	cfg.GameRules.FriendlyFire = friendlyFire
+	cfg.GameRules.FriendlyFireScale = 0.5
+	cfg.GameRules.TeamKillPenalty = 15
+	game := NewGame(cfg)
+
+	var players []*Player
+	for _, teamID := range []int{0, 0, 1} {
+		id, err := game.AddPlayer("pilot", teamID)
+		if err != nil {
+			t.Fatalf("AddPlayer failed: %v", err)
+		}
+		player, _ := game.findPlayerByID(id)
+		players = append(players, player)
+	}
+	return game, players[0], players[1], players[2]
+}
+
+// torpedoFrom creates an active torpedo fired by the given player's ship.
+func torpedoFrom(player *Player) *entity.Projectile {
+	return entity.NewTorpedo(player.ShipID).CreateProjectile(player.ShipID, physics.Vector2D{}, 0, player.TeamID)
+}
+
+func TestGame_canShipAndProjectileCollide_FriendlyFire(t *testing.T) {
+	tests := []struct {
+		name         string
+		friendlyFire bool
+		shooter      int // index into [shooter, teammate, enemy]
+		target       int
+		want         bool
+	}{
+		{"enemy hit with friendly fire off", false, 0, 2, true},
+		{"teammate hit with friendly fire off", false, 0, 1, false},
+		{"teammate hit with friendly fire on", true, 0, 1, true},
+		{"own shot with friendly fire on", true, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, a, b, c := newFriendlyFireTestGame(t, tt.friendlyFire)
+			players := []*Player{a, b, c}
+			proj := torpedoFrom(players[tt.shooter])
+			ship := game.Ships[players[tt.target].ShipID]
+
+			if got := game.canShipAndProjectileCollide(ship, proj); got != tt.want {
+				t.Errorf("canShipAndProjectileCollide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGame_FriendlyFire_ScalesTeamDamage(t *testing.T) {
+	game, shooter, teammate, enemy := newFriendlyFireTestGame(t, true)
+
+	teamShip := game.Ships[teammate.ShipID]
+	enemyShip := game.Ships[enemy.ShipID]
+	teamShip.Shields, enemyShip.Shields = 0, 0
+	teamHull, enemyHull := teamShip.Hull, enemyShip.Hull
+
+	game.processShipDamage(teamShip, torpedoFrom(shooter))
+	game.processShipDamage(enemyShip, torpedoFrom(shooter))
+
+	if got := teamHull - teamShip.Hull; got != 20 {
+		t.Errorf("teammate took %d damage, want 20", got)
+	}
+	if got := enemyHull - enemyShip.Hull; got != 40 {
+		t.Errorf("enemy took %d damage, want 40", got)
+	}
+}
+
+func TestGame_FriendlyFire_TeamKillPenalty(t *testing.T) {
+	game, shooter, teammate, _ := newFriendlyFireTestGame(t, true)
+
+	var teamKills []*event.TeamKillEvent
+	game.EventBus.Subscribe(event.TeamKill, func(e event.Event) {
+		teamKills = append(teamKills, e.(*event.TeamKillEvent))
+	})
+
+	ship := game.Ships[teammate.ShipID]
+	ship.Shields, ship.Hull = 0, 1
+	game.processShipDamage(ship, torpedoFrom(shooter))
+
+	if ship.Active {
+		t.Fatal("teammate ship should be destroyed")
+	}
+	if shooter.Score != -15 || shooter.Kills != 0 {
+		t.Errorf("shooter score=%d kills=%d, want -15 and 0", shooter.Score, shooter.Kills)
+	}
+	if teammate.Deaths != 1 {
+		t.Errorf("victim deaths = %d, want 1", teammate.Deaths)
+	}
+	if len(teamKills) != 1 {
+		t.Fatalf("expected 1 TeamKill event, got %d", len(teamKills))
+	}
+	if teamKills[0].KillerID != uint64(shooter.ID) || teamKills[0].VictimID != uint64(teammate.ID) {
+		t.Errorf("TeamKill event has killer %d victim %d", teamKills[0].KillerID, teamKills[0].VictimID)
+	}
+}
+
+func TestGame_FriendlyFire_PlanetBombing(t *testing.T) {
+	for _, friendlyFire := range []bool{false, true} {
+		game, shooter, _, _ := newFriendlyFireTestGame(t, friendlyFire)
+
+		var earth *entity.Planet
+		for _, planet := range game.Planets {
+			earth = planet
+		}
+		armies := earth.Armies
+
+		proj := torpedoFrom(shooter)
+		proj.Position = earth.Position
+		game.handleProjectilePlanetCollision(proj, earth)
+
+		if proj.Active {
+			t.Error("projectile should be absorbed by the planet")
+		}
+		if bombed := earth.Armies < armies; bombed != friendlyFire {
+			t.Errorf("friendlyFire=%v: friendly planet bombed=%v", friendlyFire, bombed)
+		}
+		if shooter.Bombs != 0 || shooter.Score != 0 {
+			t.Errorf("bombing a friendly planet should earn no credit, got bombs=%d score=%d", shooter.Bombs, shooter.Score)
+		}
+	}
+}
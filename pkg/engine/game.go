@@ -2,10 +2,12 @@
 package engine
 
 import (
+	"cmp"
 	"errors"
 	"math"
 	"math/rand/v2"
 	"runtime"
+	"slices"
 	"sync"
 	"time"
 
@@ -26,6 +28,10 @@ const (
 	GameStatusEnded
 )
 
+// CloakDetectionRadius is how close a ship must be to a cloaked enemy to detect it, both
+// to see it in state updates and to aim weapons at it
+const CloakDetectionRadius = 500.0
+
 // WinCondition defines an interface for custom win condition logic
 // Returns (winningTeamID, true) if a winner is found, else (-1, false)
 type WinCondition interface {
@@ -38,6 +44,7 @@ type Game struct {
 	Ships        map[entity.ID]*entity.Ship
 	Planets      map[entity.ID]*entity.Planet
 	Projectiles  map[entity.ID]*entity.Projectile
+	Flags        map[entity.ID]*entity.Flag // Capture the flag flags, empty in other modes
 	Teams        map[int]*Team
 	EntityLock   sync.RWMutex
 	Running      bool
@@ -50,7 +57,33 @@ type Game struct {
 	WinningTeam  int // Team ID of winner, -1 if no winner
 	EndTime      time.Time
 	StartTime    time.Time
-	ElapsedTime  float64 // seconds
+	StartTick    uint64  // Tick at which the current match started
+	ElapsedTime  float64 // seconds, derived from ticks since StartTick
+	Paused       bool    // No ticks are simulated while set, see Pause
+
+	// Clock drives Advance; the simulation itself only moves in TimeStep ticks
+	Clock Clock
+	// Rand is the game's seeded random source, used instead of the global one
+	// so that runs with the same seed and inputs are reproducible
+	Rand *rand.Rand
+
+	// tickAccumulator holds clock time not yet consumed by a full tick
+	tickAccumulator float64
+
+	// respawnQueue holds dead players waiting for a new ship, keyed by player ID
+	respawnQueue map[entity.ID]*pendingRespawn
+
+	// phaserBeams holds recently fired phaser beams until they expire from the game state
+	phaserBeams []PhaserBeamState
+	// explosions holds recent ship explosions until they expire from the game state
+	explosions []ExplosionState
+	// endTick is the tick the last match ended on, timing the intermission before the next
+	endTick uint64
+	// rotationIndex is the position in GameRules.GalaxyRotation of the next galaxy to play
+	rotationIndex int
+	// teamsUnbalanced records that a TeamsUnbalanced event has been published for the
+	// current imbalance, so players are only prompted once
+	teamsUnbalanced bool
 
 	CustomWinCondition WinCondition // Optional custom win condition
 
@@ -77,7 +110,9 @@ type Team struct {
 	Score       int
 	ShipCount   int
 	PlanetCount int
-	Players     map[entity.ID]*Player
+	// FlagCaptures counts the enemy flags the team has captured in capture the flag
+	FlagCaptures int
+	Players      map[entity.ID]*Player
 }
 
 // Player represents a connected player
@@ -92,6 +127,11 @@ type Player struct {
 	Deaths    int
 	Bombs     int
 	Captures  int
+	ShipClass entity.ShipClass // Class flown on the next (re)spawn
+	// KillStreak counts enemy kills since the player's last death
+	KillStreak int
+	// Ready marks the player as ready to start a tournament match from the lobby
+	Ready bool
 }
 
 // NewGame creates a new game with the specified configuration
@@ -110,23 +150,33 @@ func NewGame(config *config.GameConfig) *Game {
 		"planets_count": len(config.Planets),
 	}).Info("Creating new game instance")
 
+	clock := SystemClock{}
+	seed := config.Seed
+	if seed == 0 {
+		seed = uint64(clock.Now().UnixNano())
+	}
+
 	game := &Game{
-		Config:      config,
-		Ships:       make(map[entity.ID]*entity.Ship),
-		Planets:     make(map[entity.ID]*entity.Planet),
-		Projectiles: make(map[entity.ID]*entity.Projectile),
-		Teams:       make(map[int]*Team),
-		TimeStep:    1.0 / 60.0, // 60 FPS
-		CurrentTick: 0,
-		LastUpdate:  time.Now(),
-		EventBus:    event.NewEventBus(),
-		logger:      logger,
+		Config:       config,
+		Ships:        make(map[entity.ID]*entity.Ship),
+		Planets:      make(map[entity.ID]*entity.Planet),
+		Projectiles:  make(map[entity.ID]*entity.Projectile),
+		Teams:        make(map[int]*Team),
+		TimeStep:     1.0 / 60.0, // 60 FPS
+		CurrentTick:  0,
+		LastUpdate:   clock.Now(),
+		EventBus:     event.NewEventBus(),
+		Clock:        clock,
+		Rand:         rand.New(rand.NewPCG(seed, seed)),
+		respawnQueue: make(map[entity.ID]*pendingRespawn),
+		logger:       logger,
 	}
 
 	logger.WithField("caller", caller).WithFields(logrus.Fields{
 		"function":     "NewGame",
 		"time_step":    game.TimeStep,
 		"current_tick": game.CurrentTick,
+		"seed":         seed,
 	}).Info("Game struct initialized")
 
 	// Initialize game components first
@@ -138,6 +188,7 @@ func NewGame(config *config.GameConfig) *Game {
 
 	logger.WithField("caller", caller).WithField("function", "NewGame").Info("Initializing planets")
 	game.initPlanets()
+	game.initFlags()
 
 	logger.WithField("caller", caller).WithFields(logrus.Fields{
 		"function":      "NewGame",
@@ -341,31 +392,51 @@ func (g *Game) initPlanets() {
 	}).Info("All planets initialized successfully")
 }
 
-// Start begins the game update loop
+// Start begins the game update loop. In tournament mode the game opens in the lobby
+// and the match only begins once enough players on every team are ready.
 func (g *Game) Start() {
 	caller := getCallerInfo()
 	g.logger.WithField("caller", caller).WithField("function", "Start").Info("Starting game")
 
 	g.Running = true
+	g.LastUpdate = g.Clock.Now()
+	g.tickAccumulator = 0
+
+	if g.Config.GameRules.Tournament {
+		g.Status = GameStatusWaiting
+		g.logger.WithField("caller", caller).WithFields(logrus.Fields{
+			"function":           "Start",
+			"min_ready_per_team": g.minReadyPerTeam(),
+		}).Info("Game opened in tournament lobby")
+		return
+	}
+
+	g.beginMatch()
+	g.logger.WithField("caller", caller).WithField("function", "Start").Info("Game started successfully")
+}
+
+// beginMatch makes the game active, starts the match clock and publishes GameStarted.
+func (g *Game) beginMatch() {
+	caller := getCallerInfo()
+
 	g.Status = GameStatusActive
-	g.StartTime = time.Now()
-	g.LastUpdate = time.Now()
+	g.StartTime = g.Clock.Now()
+	g.StartTick = g.CurrentTick
 
 	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
-		"function":    "Start",
+		"function":    "beginMatch",
 		"running":     g.Running,
 		"status":      g.Status,
 		"start_time":  g.StartTime,
+		"start_tick":  g.StartTick,
 		"last_update": g.LastUpdate,
 	}).Info("Game state updated to active")
 
-	g.logger.WithField("caller", caller).WithField("function", "Start").Info("Publishing game started event")
+	g.logger.WithField("caller", caller).WithField("function", "beginMatch").Info("Publishing game started event")
 	g.EventBus.Publish(&event.BaseEvent{
 		EventType: event.GameStarted,
 		Source:    g,
 	})
-
-	g.logger.WithField("caller", caller).WithField("function", "Start").Info("Game started successfully")
 }
 
 // Stop halts the game update loop
@@ -391,7 +462,60 @@ func (g *Game) Stop() {
 	g.logger.WithField("caller", caller).WithField("function", "Stop").Info("Game stopped successfully")
 }
 
-// Update advances the game state by one tick
+// SetSeed reseeds the game's random source.
+// Two games with the same seed fed the same inputs tick for tick evolve identically.
+func (g *Game) SetSeed(seed uint64) {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	g.Rand = rand.New(rand.NewPCG(seed, seed))
+}
+
+// maxCatchUpTime bounds how much clock time a single Advance may consume,
+// so a stalled process does not spiral trying to catch up.
+const maxCatchUpTime = 0.1 // seconds
+
+// Advance runs as many fixed TimeStep ticks as the clock says are due since
+// the previous call and returns the number of ticks executed. Leftover time
+// smaller than one tick is carried over to the next call.
+func (g *Game) Advance() int {
+	caller := getCallerInfo()
+
+	now := g.Clock.Now()
+	elapsed := now.Sub(g.LastUpdate).Seconds()
+	g.LastUpdate = now
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	g.tickAccumulator += elapsed
+	if g.tickAccumulator > maxCatchUpTime {
+		g.logger.WithField("caller", caller).WithFields(logrus.Fields{
+			"function":       "Advance",
+			"behind_seconds": g.tickAccumulator,
+			"capped_seconds": maxCatchUpTime,
+		}).Debug("Clock too far ahead, dropping excess time")
+		g.tickAccumulator = maxCatchUpTime
+	}
+
+	ticks := 0
+	for g.tickAccumulator >= g.TimeStep {
+		g.tickAccumulator -= g.TimeStep
+		g.Update()
+		ticks++
+	}
+
+	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
+		"function":      "Advance",
+		"ticks_run":     ticks,
+		"current_tick":  g.CurrentTick,
+		"carry_seconds": g.tickAccumulator,
+	}).Debug("Advanced game clock")
+
+	return ticks
+}
+
+// Update advances the game state by exactly one fixed TimeStep tick, or does nothing while paused
 func (g *Game) Update() {
 	caller := getCallerInfo()
 	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
@@ -400,11 +524,7 @@ func (g *Game) Update() {
 		"running":      g.Running,
 	}).Debug("Starting game update cycle")
 
-	deltaTime := g.calculateDeltaTime()
-	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
-		"function":   "Update",
-		"delta_time": deltaTime,
-	}).Debug("Calculated delta time")
+	deltaTime := g.TimeStep
 
 	// Lock the entire update to ensure consistency across all entity operations
 	g.logger.WithField("caller", caller).WithField("function", "Update").Debug("Acquiring entity lock")
@@ -414,6 +534,10 @@ func (g *Game) Update() {
 		g.logger.WithField("caller", caller).WithField("function", "Update").Debug("Released entity lock")
 	}()
 
+	if g.Paused {
+		return
+	}
+
 	g.logger.WithField("caller", caller).WithField("function", "Update").Debug("Checking time limit")
 	g.checkTimeLimit()
 
@@ -422,6 +546,7 @@ func (g *Game) Update() {
 
 	g.logger.WithField("caller", caller).WithField("function", "Update").Debug("Updating game state")
 	g.updateGameState(deltaTime)
+	g.checkIntermission()
 
 	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
 		"function":     "Update",
@@ -435,7 +560,7 @@ func (g *Game) checkTimeLimit() {
 	caller := getCallerInfo()
 
 	if g.Status == GameStatusActive {
-		g.ElapsedTime = time.Since(g.StartTime).Seconds()
+		g.ElapsedTime = g.ticksToSeconds(g.CurrentTick - g.StartTick)
 
 		g.logger.WithField("caller", caller).WithFields(logrus.Fields{
 			"function":           "checkTimeLimit",
@@ -500,6 +625,9 @@ func (g *Game) checkWinConditions() {
 	case "score":
 		g.logger.WithField("caller", caller).WithField("function", "checkWinConditions").Debug("Checking score win condition")
 		g.checkScoreWin()
+	case "ctf":
+		g.logger.WithField("caller", caller).WithField("function", "checkWinConditions").Debug("Checking capture the flag win condition")
+		g.checkCTFWin()
 	default:
 		g.logger.WithField("caller", caller).WithFields(logrus.Fields{
 			"function":          "checkWinConditions",
@@ -613,54 +741,51 @@ func (g *Game) checkScoreWin() {
 	g.logger.WithField("caller", caller).WithField("function", "checkScoreWin").Debug("No team has achieved score victory")
 }
 
-// calculateDeltaTime calculates the time since the last update and caps it.
-func (g *Game) calculateDeltaTime() float64 {
-	caller := getCallerInfo()
-
-	now := time.Now()
-	deltaTime := now.Sub(g.LastUpdate).Seconds()
-	g.LastUpdate = now
-
-	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
-		"function":       "calculateDeltaTime",
-		"raw_delta_time": deltaTime,
-		"last_update":    g.LastUpdate,
-	}).Debug("Calculated raw delta time")
+// ticksToSeconds converts a tick count into simulated seconds.
+func (g *Game) ticksToSeconds(ticks uint64) float64 {
+	return float64(ticks) * g.TimeStep
+}
 
-	// Cap delta time to prevent physics issues
-	if deltaTime > 0.1 {
-		g.logger.WithField("caller", caller).WithFields(logrus.Fields{
-			"function":          "calculateDeltaTime",
-			"raw_delta_time":    deltaTime,
-			"capped_delta_time": 0.1,
-		}).Debug("Delta time capped to prevent physics issues")
-		deltaTime = 0.1
+// secondsToTicks converts simulated seconds into a whole number of ticks, rounding up.
+func (g *Game) secondsToTicks(seconds float64) uint64 {
+	if seconds <= 0 {
+		return 0
 	}
-
-	return deltaTime
+	return uint64(math.Ceil(seconds/g.TimeStep - 1e-9))
 }
 
 // updateGameState updates all entities, processes collisions, and cleans up.
 func (g *Game) updateGameState(deltaTime float64) {
-	g.updateEntities(deltaTime)
+	g.processRespawnQueue()
+	ships := g.shipsInOrder()
+	g.updateEntities(deltaTime, ships)
 	g.processCollisions()
+	g.updateFlags(ships)
 	g.cleanupInactiveEntities()
 	g.CurrentTick++
+	g.expirePhaserBeams()
+	g.expireExplosions()
+	if g.Status == GameStatusActive {
+		g.ElapsedTime = g.ticksToSeconds(g.CurrentTick - g.StartTick)
+	}
 }
 
-// updateEntities updates all entities and the spatial index.
-func (g *Game) updateEntities(deltaTime float64) {
+// updateEntities updates all entities and the spatial index. ships is every ship in ID
+// order, built once per tick.
+func (g *Game) updateEntities(deltaTime float64, ships []*entity.Ship) {
 	g.prepareSpatialIndex()
 
 	// Update all entities
-	g.updateShips(deltaTime)
+	g.updateShips(deltaTime, ships)
 	g.updateProjectiles(deltaTime)
 	g.updatePlanets(deltaTime)
+	g.updatePlanetDefenses(ships)
 
 	g.populateSpatialIndex()
 }
 
 // prepareSpatialIndex clears the spatial index for the new frame or initializes it.
+// Planets never move, so they are indexed up front where entity updates can query them.
 func (g *Game) prepareSpatialIndex() {
 	if g.SpatialIndex == nil {
 		g.SpatialIndex = physics.NewQuadTree(
@@ -674,26 +799,26 @@ func (g *Game) prepareSpatialIndex() {
 	} else {
 		g.SpatialIndex.Clear()
 	}
+
+	for _, planet := range g.planetsInOrder() {
+		g.SpatialIndex.Insert(planet.Position, planet)
+	}
 }
 
-// populateSpatialIndex adds all active entities to the spatial index.
+// populateSpatialIndex adds all active ships and projectiles to the spatial index.
 func (g *Game) populateSpatialIndex() {
 	// Note: Called from within locked context in Update()
-	for _, ship := range g.Ships {
+	for _, ship := range g.shipsInOrder() {
 		if ship.Active {
 			g.SpatialIndex.Insert(ship.Position, ship)
 		}
 	}
 
-	for _, projectile := range g.Projectiles {
+	for _, projectile := range g.projectilesInOrder() {
 		if projectile.Active {
 			g.SpatialIndex.Insert(projectile.Position, projectile)
 		}
 	}
-
-	for _, planet := range g.Planets {
-		g.SpatialIndex.Insert(planet.Position, planet)
-	}
 }
 
 // processCollisions checks for and resolves collisions between entities.
@@ -702,27 +827,42 @@ func (g *Game) processCollisions() {
 }
 
 // updateShips updates all ships
-func (g *Game) updateShips(deltaTime float64) {
+func (g *Game) updateShips(deltaTime float64, ships []*entity.Ship) {
 	// Note: Called from within locked context in Update()
-	for _, ship := range g.Ships {
+	g.applyTractorBeams(deltaTime)
+
+	for _, ship := range ships {
 		if ship.Active {
+			g.breakOrbitIfManeuvering(ship)
+			g.breakDockIfManeuvering(ship)
+			if ship.Orbiting == 0 && ship.DockedTo == 0 {
+				g.applyShipGravity(ship, deltaTime)
+			}
 			ship.Update(deltaTime)
+			g.updateOrbit(ship, deltaTime)
 
 			// Wrap ships around the world boundaries
-			g.wrapEntityPosition(ship)
+			g.wrapEntityPosition(ship, ships)
 		}
 	}
+
+	g.updateDockedShips(deltaTime, ships)
 }
 
 // updateProjectiles updates all projectiles
 func (g *Game) updateProjectiles(deltaTime float64) {
 	// Note: Called from within locked context in Update()
-	for _, proj := range g.Projectiles {
+	for _, proj := range g.projectilesInOrder() {
 		if proj.Active {
+			g.applyGravity(&proj.Velocity, proj.Position, deltaTime)
+			g.steerHomingProjectile(proj, deltaTime)
 			proj.Update(deltaTime)
+			if !proj.Active && proj.BlastRadius > 0 {
+				g.explodeProjectile(proj) // Reached the end of its range
+			}
 
 			// Wrap projectiles around the world boundaries
-			g.wrapEntityPosition(proj)
+			g.wrapEntityPosition(proj, nil)
 		}
 	}
 }
@@ -735,15 +875,19 @@ func (g *Game) updatePlanets(deltaTime float64) {
 	}
 }
 
-// wrapEntityPosition wraps an entity's position around the world boundaries
-func (g *Game) wrapEntityPosition(e interface{}) {
+// wrapEntityPosition wraps an entity's position around the world boundaries. A wrapped
+// ship is then nudged clear of the other ships in ships.
+func (g *Game) wrapEntityPosition(e interface{}, ships []*entity.Ship) {
 	pos, radius, ok := g.extractEntityPositionData(e)
 	if !ok {
 		return
 	}
 
 	g.wrapCoordinatesAroundWorld(pos)
-	g.resolvePositionCollisions(e, pos, radius)
+	if _, isShip := e.(*entity.Ship); isShip {
+		// Only ships are kept apart; projectiles must be able to reach a ship to hit it
+		g.resolvePositionCollisions(e, pos, radius, ships)
+	}
 }
 
 // extractEntityPositionData extracts position and radius from an entity interface.
@@ -782,10 +926,11 @@ func (g *Game) wrapCoordinatesAroundWorld(pos *physics.Vector2D) {
 
 // resolvePositionCollisions nudges the entity away from any overlapping ships.
 // It prevents entities from overlapping after position wrapping by adjusting their position.
-func (g *Game) resolvePositionCollisions(e interface{}, pos *physics.Vector2D, radius float64) {
+// ships is passed in rather than rebuilt so the ordered list is only sorted once per tick.
+func (g *Game) resolvePositionCollisions(e interface{}, pos *physics.Vector2D, radius float64, ships []*entity.Ship) {
 	// Note: This method is called from within already-locked sections,
 	// so we don't need additional locking here
-	for _, other := range g.Ships {
+	for _, other := range ships {
 		if other == e || !other.Active {
 			continue
 		}
@@ -802,6 +947,7 @@ func (g *Game) resolvePositionCollisions(e interface{}, pos *physics.Vector2D, r
 // detectCollisions checks for and resolves collisions between entities
 func (g *Game) detectCollisions() {
 	g.processShipProjectileCollisions()
+	g.processShipShipCollisions()
 	g.processShipPlanetCollisions()
 	g.processProjectilePlanetCollisions()
 }
@@ -809,7 +955,7 @@ func (g *Game) detectCollisions() {
 // processShipProjectileCollisions handles collisions between ships and projectiles.
 func (g *Game) processShipProjectileCollisions() {
 	// Note: Called from within locked context in Update()
-	for _, ship := range g.Ships {
+	for _, ship := range g.shipsInOrder() {
 		g.checkCollisionsForShip(ship)
 	}
 }
@@ -844,53 +990,238 @@ func (g *Game) handleShipProjectileCollision(ship *entity.Ship, projectile *enti
 }
 
 // canShipAndProjectileCollide determines if a collision check is necessary.
+// Teammates are only hit when friendly fire is enabled, and never by their own shots.
 func (g *Game) canShipAndProjectileCollide(ship *entity.Ship, projectile *entity.Projectile) bool {
-	return projectile.Active && projectile.TeamID != ship.TeamID
+	return projectile.Active && g.canAttackShip(ship, projectile.OwnerID, projectile.TeamID)
+}
+
+// canAttackShip applies the friendly fire rules to an attack on a ship by the given
+// ship and team. Ships never damage themselves.
+func (g *Game) canAttackShip(ship *entity.Ship, attackerID entity.ID, attackerTeamID int) bool {
+	if attackerID == ship.ID {
+		return false
+	}
+	if attackerTeamID == ship.TeamID {
+		return g.Config.GameRules.FriendlyFire
+	}
+	return true
+}
+
+// damageAgainst returns the damage an attacker deals to a target of the given team,
+// scaled down by GameRules.FriendlyFireScale for hits on the attacker's own team.
+func (g *Game) damageAgainst(damage, attackerTeamID, targetTeamID int) int {
+	if attackerTeamID != targetTeamID {
+		return damage
+	}
+	scale := g.Config.GameRules.FriendlyFireScale
+	if scale <= 0 {
+		return damage
+	}
+	return int(math.Round(float64(damage) * scale))
 }
 
 // processShipDamage handles the consequences of a ship taking damage from a projectile.
+// Projectiles with a blast radius explode, damaging every ship nearby instead.
 func (g *Game) processShipDamage(ship *entity.Ship, projectile *entity.Projectile) {
-	destroyed := ship.TakeDamage(projectile.Damage)
+	if projectile.BlastRadius > 0 {
+		g.explodeProjectile(projectile)
+		return
+	}
 	projectile.Active = false
+	g.applyProjectileDamage(ship, projectile, projectile.Damage)
+}
+
+// applyProjectileDamage deals damage from a projectile to a ship and credits any kill to its owner.
+func (g *Game) applyProjectileDamage(ship *entity.Ship, projectile *entity.Projectile, damage int) {
+	g.applyWeaponDamage(ship, damage, projectile.ID, projectile.OwnerID, projectile.TeamID)
+}
+
+// applyWeaponDamage deals damage to a ship from a weapon hit, publishes a collision with
+// the hitting entity and credits any kill to the attacking ship.
+func (g *Game) applyWeaponDamage(ship *entity.Ship, damage int, sourceID, attackerID entity.ID, attackerTeamID int) {
+	destroyed := ship.TakeDamage(g.damageAgainst(damage, attackerTeamID, ship.TeamID))
 
 	g.EventBus.Publish(event.NewCollisionEvent(
 		g,
 		uint64(ship.ID),
-		uint64(projectile.ID),
+		uint64(sourceID),
 	))
 
 	if destroyed {
-		g.handleShipDestruction(ship, projectile)
+		g.handleShipDestruction(ship, attackerID, attackerTeamID)
 	}
 }
 
 // handleShipDestruction manages the game state changes when a ship is destroyed.
-func (g *Game) handleShipDestruction(ship *entity.Ship, projectile *entity.Projectile) {
+// killerID is the ship or planet credited with the kill and killerTeamID its team.
+// The ship then explodes, damaging everything nearby on the killer's behalf.
+func (g *Game) handleShipDestruction(ship *entity.Ship, killerID entity.ID, killerTeamID int) {
 	ship.Active = false
-	g.updatePlayerStatsOnShipDestruction(ship, projectile)
+	g.updatePlayerStatsOnShipDestruction(ship, killerID, killerTeamID)
+	g.queueRespawn(ship)
 	g.EventBus.Publish(event.NewShipEvent(
 		event.ShipDestroyed,
 		g,
 		uint64(ship.ID),
 		ship.TeamID,
 	))
+	g.explodeShip(ship, killerID, killerTeamID)
 }
 
-// updatePlayerStatsOnShipDestruction updates player stats when a ship is destroyed by a projectile.
-func (g *Game) updatePlayerStatsOnShipDestruction(ship *entity.Ship, projectile *entity.Projectile) {
-	if player, ok := g.findPlayerByShipID(projectile.OwnerID); ok {
+// updatePlayerStatsOnShipDestruction updates player stats when a ship is destroyed.
+func (g *Game) updatePlayerStatsOnShipDestruction(ship *entity.Ship, killerID entity.ID, killerTeamID int) {
+	if !g.statsCount() {
+		return
+	}
+	if killerTeamID == ship.TeamID {
+		g.handleTeamKill(ship, killerID)
+	} else if player, ok := g.findPlayerByShipID(killerID); ok {
 		player.Kills++
+		player.KillStreak++
 		player.Score += 10 // Points for kill
+	} else if _, ok := g.Planets[killerID]; ok {
+		g.creditPlanetKill(killerTeamID)
 	}
 	if player, ok := g.findPlayerByShipID(ship.ID); ok {
 		player.Deaths++
+		player.KillStreak = 0
+	}
+}
+
+// handleTeamKill applies the team-kill penalty to the killer and publishes a TeamKill event.
+func (g *Game) handleTeamKill(ship *entity.Ship, killerShipID entity.ID) {
+	var killerID, victimID entity.ID
+	if killer, ok := g.findPlayerByShipID(killerShipID); ok {
+		killer.Score -= g.Config.GameRules.TeamKillPenalty
+		killerID = killer.ID
+	}
+	if victim, ok := g.findPlayerByShipID(ship.ID); ok {
+		victimID = victim.ID
+	}
+
+	g.EventBus.Publish(event.NewTeamKillEvent(
+		g,
+		uint64(killerID),
+		uint64(victimID),
+		ship.TeamID,
+	))
+}
+
+// collisionDamageSpeed is the closing speed, in units per second, at which a ship-ship
+// collision deals PhysicsConfig.CollisionDamage to each of two equal-mass ships.
+const collisionDamageSpeed = 100.0
+
+// processShipShipCollisions handles collisions between pairs of ships.
+func (g *Game) processShipShipCollisions() {
+	// Note: Called from within locked context in Update()
+	maxRadius := g.maxShipRadius()
+	for _, ship := range g.shipsInOrder() {
+		g.checkShipCollisionsForShip(ship, maxRadius)
+	}
+}
+
+// maxShipRadius returns the largest collider radius among active ships.
+func (g *Game) maxShipRadius() float64 {
+	maxRadius := 0.0
+	for _, ship := range g.Ships {
+		if ship.Active && ship.Collider.Radius > maxRadius {
+			maxRadius = ship.Collider.Radius
+		}
+	}
+	return maxRadius
+}
+
+// checkShipCollisionsForShip finds and handles collisions between a ship and ships with a
+// higher ID, so that each pair is resolved exactly once per tick.
+func (g *Game) checkShipCollisionsForShip(ship *entity.Ship, maxRadius float64) {
+	if !ship.Active {
+		return
+	}
+	shipArea := physics.Rect{
+		Center: ship.Position,
+		Width:  (ship.Collider.Radius + maxRadius) * 2,
+		Height: (ship.Collider.Radius + maxRadius) * 2,
+	}
+	potentialCollisions := g.SpatialIndex.Query(shipArea)
+	for _, other := range potentialCollisions {
+		if otherShip, ok := other.(*entity.Ship); ok && otherShip.ID > ship.ID {
+			g.handleShipShipCollision(ship, otherShip)
+		}
+	}
+}
+
+// handleShipShipCollision resolves an elastic collision between two ships and applies impact damage.
+func (g *Game) handleShipShipCollision(a, b *entity.Ship) {
+	if !a.Active || !b.Active {
+		return
+	}
+
+	collision := physics.CheckCollision(a.GetCollider(), b.GetCollider())
+	if !collision.Collided {
+		return
+	}
+
+	g.separateShips(a, b, collision)
+
+	// Only ships moving towards each other exchange momentum; resting contact is just separated
+	closingSpeed := a.Velocity.Sub(b.Velocity).Dot(collision.Normal)
+	if closingSpeed <= 0 {
+		return
+	}
+
+	g.applyElasticImpulse(a, b, collision.Normal, closingSpeed)
+	g.EventBus.Publish(event.NewCollisionEvent(
+		g,
+		uint64(a.ID),
+		uint64(b.ID),
+	))
+	g.applyCollisionDamage(a, b, closingSpeed)
+}
+
+// separateShips pushes two overlapping ships apart along the collision normal,
+// moving the lighter ship further.
+func (g *Game) separateShips(a, b *entity.Ship, collision physics.CollisionResult) {
+	totalMass := a.Mass() + b.Mass()
+	a.Position = a.Position.Sub(collision.Normal.Scale(collision.Penetration * b.Mass() / totalMass))
+	b.Position = b.Position.Add(collision.Normal.Scale(collision.Penetration * a.Mass() / totalMass))
+	a.Collider.Center = a.Position
+	b.Collider.Center = b.Position
+}
+
+// applyElasticImpulse exchanges momentum between two ships along the collision normal.
+func (g *Game) applyElasticImpulse(a, b *entity.Ship, normal physics.Vector2D, closingSpeed float64) {
+	impulse := 2 * closingSpeed / (1/a.Mass() + 1/b.Mass())
+	a.Velocity = a.Velocity.Sub(normal.Scale(impulse / a.Mass()))
+	b.Velocity = b.Velocity.Add(normal.Scale(impulse / b.Mass()))
+}
+
+// applyCollisionDamage damages both ships in proportion to their closing speed and the other
+// ship's mass, crediting any kill to the ship that rammed it.
+func (g *Game) applyCollisionDamage(a, b *entity.Ship, closingSpeed float64) {
+	if a.TeamID == b.TeamID && !g.Config.GameRules.FriendlyFire {
+		return
+	}
+
+	baseDamage := g.Config.PhysicsConfig.CollisionDamage * closingSpeed / collisionDamageSpeed
+	totalMass := a.Mass() + b.Mass()
+	damageToA := g.damageAgainst(int(math.Round(baseDamage*2*b.Mass()/totalMass)), b.TeamID, a.TeamID)
+	damageToB := g.damageAgainst(int(math.Round(baseDamage*2*a.Mass()/totalMass)), a.TeamID, b.TeamID)
+
+	destroyedA := damageToA > 0 && a.TakeDamage(damageToA)
+	destroyedB := damageToB > 0 && b.TakeDamage(damageToB)
+
+	if destroyedA {
+		g.handleShipDestruction(a, b.ID, b.TeamID)
+	}
+	if destroyedB {
+		g.handleShipDestruction(b, a.ID, a.TeamID)
 	}
 }
 
 // processShipPlanetCollisions handles ship-planet proximity and collision responses.
 func (g *Game) processShipPlanetCollisions() {
 	// Note: Called from within locked context in Update()
-	for _, ship := range g.Ships {
+	for _, ship := range g.shipsInOrder() {
 		g.checkInteractionsForShip(ship)
 	}
 }
@@ -929,7 +1260,7 @@ func (g *Game) handleShipPlanetInteraction(ship *entity.Ship, planet *entity.Pla
 // processProjectilePlanetCollisions handles collisions between projectiles and planets.
 func (g *Game) processProjectilePlanetCollisions() {
 	// Note: Called from within locked context in Update()
-	for _, proj := range g.Projectiles {
+	for _, proj := range g.projectilesInOrder() {
 		g.checkCollisionsForProjectile(proj)
 	}
 }
@@ -956,38 +1287,88 @@ func (g *Game) checkCollisionsForProjectile(proj *entity.Projectile) {
 func (g *Game) handleProjectilePlanetCollision(proj *entity.Projectile, planet *entity.Planet) {
 	if proj.Position.Distance(planet.Position) < proj.Collider.Radius+planet.Collider.Radius {
 		proj.Active = false
-		if planet.TeamID >= 0 && planet.TeamID != proj.TeamID {
+		if g.canProjectileBombPlanet(proj, planet) {
 			g.processPlanetBombing(proj, planet)
 		}
+		if proj.BlastRadius > 0 {
+			g.explodeProjectile(proj)
+		}
 	}
 }
 
+// canProjectileBombPlanet reports whether a projectile hitting a planet kills armies.
+// Friendly planets are only affected when friendly fire is enabled, and no planet is
+// bombed in the tournament lobby so the match starts with the galaxy untouched.
+func (g *Game) canProjectileBombPlanet(proj *entity.Projectile, planet *entity.Planet) bool {
+	if planet.TeamID < 0 || g.inLobby() {
+		return false
+	}
+	if planet.TeamID == proj.TeamID {
+		return g.Config.GameRules.FriendlyFire
+	}
+	return true
+}
+
 // processPlanetBombing handles the logic when a projectile bombs a planet.
+// Bombing a friendly planet earns no credit.
 func (g *Game) processPlanetBombing(proj *entity.Projectile, planet *entity.Planet) {
-	armiesKilled := planet.Bomb(proj.Damage / 2) // Reduced damage for bombing
-	if player, ok := g.findPlayerByShipID(proj.OwnerID); ok {
+	oldTeamID := planet.TeamID
+	armiesKilled := planet.Bomb(g.damageAgainst(proj.Damage, proj.TeamID, planet.TeamID) / 2) // Reduced damage for bombing
+	if player, ok := g.findPlayerByShipID(proj.OwnerID); ok && oldTeamID != proj.TeamID && g.statsCount() {
 		player.Bombs += armiesKilled
 		player.Score += armiesKilled // Points for bombing
 	}
 	if planet.TeamID == -1 { // Planet was just neutralized
-		g.handlePlanetNeutralization(planet)
+		g.handlePlanetNeutralization(planet, oldTeamID)
 	}
 }
 
 // handlePlanetNeutralization updates game state when a planet becomes neutral.
-func (g *Game) handlePlanetNeutralization(planet *entity.Planet) {
-	if team, ok := g.Teams[planet.TeamID]; ok {
+func (g *Game) handlePlanetNeutralization(planet *entity.Planet, oldTeamID int) {
+	if team, ok := g.Teams[oldTeamID]; ok {
 		team.PlanetCount--
 	}
 	g.EventBus.Publish(event.NewPlanetEvent(
 		event.PlanetCaptured, // Or a new "PlanetNeutralized" event type
 		g,
 		uint64(planet.ID),
-		-1,            // New team is neutral
-		planet.TeamID, // Old team ID
+		-1,        // New team is neutral
+		oldTeamID, // Old team ID
 	))
 }
 
+// shipsInOrder returns all ships sorted by ID.
+// Map iteration order is randomized in Go, so every simulation pass that can
+// affect the outcome walks entities in ID order to stay deterministic.
+func (g *Game) shipsInOrder() []*entity.Ship {
+	ships := make([]*entity.Ship, 0, len(g.Ships))
+	for _, ship := range g.Ships {
+		ships = append(ships, ship)
+	}
+	slices.SortFunc(ships, func(a, b *entity.Ship) int { return cmp.Compare(a.ID, b.ID) })
+	return ships
+}
+
+// projectilesInOrder returns all projectiles sorted by ID.
+func (g *Game) projectilesInOrder() []*entity.Projectile {
+	projectiles := make([]*entity.Projectile, 0, len(g.Projectiles))
+	for _, proj := range g.Projectiles {
+		projectiles = append(projectiles, proj)
+	}
+	slices.SortFunc(projectiles, func(a, b *entity.Projectile) int { return cmp.Compare(a.ID, b.ID) })
+	return projectiles
+}
+
+// planetsInOrder returns all planets sorted by ID.
+func (g *Game) planetsInOrder() []*entity.Planet {
+	planets := make([]*entity.Planet, 0, len(g.Planets))
+	for _, planet := range g.Planets {
+		planets = append(planets, planet)
+	}
+	slices.SortFunc(planets, func(a, b *entity.Planet) int { return cmp.Compare(a.ID, b.ID) })
+	return planets
+}
+
 // findPlayerByShipID finds a player by their ship ID
 func (g *Game) findPlayerByShipID(shipID entity.ID) (*Player, bool) {
 	for _, team := range g.Teams {
@@ -1015,7 +1396,8 @@ func (g *Game) cleanupInactiveEntities() {
 	// Ships are respawned, not deleted
 }
 
-// AddPlayer adds a new player to the game
+// AddPlayer adds a new player to the game. Pass AutoTeam as the teamID to join the
+// smallest team; joining fails once the team or the game is full.
 func (g *Game) AddPlayer(name string, teamID int) (entity.ID, error) {
 	g.EntityLock.Lock()
 	defer g.EntityLock.Unlock()
@@ -1030,16 +1412,24 @@ func (g *Game) AddPlayer(name string, teamID int) (entity.ID, error) {
 	g.assignShipToPlayer(player, ship, team)
 
 	g.publishPlayerAndShipCreationEvents(player, ship)
+	g.checkTeamBalance()
 
 	return player.ID, nil
 }
 
-// validateTeam checks if the teamID is valid and returns the team.
+// validateTeam checks if the teamID is valid and the team has room for another
+// player, and returns the team. AutoTeam picks the smallest team with room.
 func (g *Game) validateTeam(teamID int) (*Team, error) {
+	if teamID == AutoTeam {
+		return g.autoTeam()
+	}
 	team, ok := g.Teams[teamID]
 	if !ok {
 		return nil, errors.New("invalid team")
 	}
+	if err := g.checkTeamCapacity(team); err != nil {
+		return nil, err
+	}
 	return team, nil
 }
 
@@ -1070,22 +1460,29 @@ func (g *Game) createPlayer(name string, teamID int) *Player {
 		Name:      name,
 		TeamID:    teamID,
 		Connected: true,
+		ShipClass: g.startingShipClass(teamID),
 	}
 }
 
+// startingShipClass returns the configured starting ship class for a team.
+func (g *Game) startingShipClass(teamID int) entity.ShipClass {
+	if teamID >= 0 && teamID < len(g.Config.Teams) {
+		return entity.ShipClassFromString(g.Config.Teams[teamID].StartingShip)
+	}
+	return entity.Scout
+}
+
 // createShipForPlayer creates a new ship for a given player.
 func (g *Game) createShipForPlayer(player *Player) *entity.Ship {
 	spawnPoint := g.findSpawnPoint(player.TeamID)
-	shipClass := entity.Scout
-	if player.TeamID < len(g.Config.Teams) {
-		shipClass = entity.ShipClassFromString(g.Config.Teams[player.TeamID].StartingShip)
-	}
-	return entity.NewShip(
+	ship := entity.NewShip(
 		entity.GenerateID(),
-		shipClass,
+		player.ShipClass,
 		player.TeamID,
 		spawnPoint,
 	)
+	ship.PlayerID = player.ID
+	return ship
 }
 
 // publishPlayerAndShipCreationEvents publishes events for player joining and ship creation.
@@ -1114,7 +1511,9 @@ func (g *Game) RemovePlayer(playerID entity.ID) error {
 
 	g.deactivatePlayerShip(player)
 	g.removePlayerFromTeam(player, team)
+	delete(g.respawnQueue, player.ID)
 	g.publishPlayerLeftEvent(player)
+	g.checkTeamBalance()
 
 	return nil
 }
@@ -1158,7 +1557,7 @@ func (g *Game) publishPlayerLeftEvent(player *Player) {
 	})
 }
 
-// RespawnShip respawns a player's ship
+// RespawnShip respawns a player's ship immediately, skipping any remaining respawn delay
 func (g *Game) RespawnShip(playerID entity.ID) error {
 	g.EntityLock.Lock()
 	defer g.EntityLock.Unlock()
@@ -1168,6 +1567,16 @@ func (g *Game) RespawnShip(playerID entity.ID) error {
 		return err
 	}
 
+	g.respawnShipInternal(player)
+
+	return nil
+}
+
+// respawnShipInternal replaces a player's ship with a new one near the team homeworld
+// and removes the player from the death queue (must be called with lock held).
+func (g *Game) respawnShipInternal(player *Player) {
+	delete(g.respawnQueue, player.ID)
+
 	// Create a new ship
 	newShip := g.createShipForPlayer(player)
 
@@ -1184,10 +1593,137 @@ func (g *Game) RespawnShip(playerID entity.ID) error {
 		uint64(newShip.ID),
 		player.TeamID,
 	))
+	g.EventBus.Publish(event.NewShipEvent(
+		event.ShipRespawned,
+		g,
+		uint64(newShip.ID),
+		player.TeamID,
+	))
+}
+
+// RequestShipClass validates a ship class change for a player and schedules it.
+// The change takes effect the next time the player's ship is created by RespawnShip.
+// Requests are only honoured while the player's ship is dead or orbiting a friendly
+// industrial planet or homeworld.
+func (g *Game) RequestShipClass(playerID entity.ID, class entity.ShipClass) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	player, err := g.findPlayerByID(playerID)
+	if err != nil {
+		return err
+	}
+
+	if err := g.validateShipClassAvailable(class); err != nil {
+		return err
+	}
+	if err := g.validateShipClassTeamLimit(player, class); err != nil {
+		return err
+	}
+	if err := g.validateShipClassKills(player, class); err != nil {
+		return err
+	}
+	if err := g.validateShipClassChangeLocation(player); err != nil {
+		return err
+	}
+
+	player.ShipClass = class
+	return nil
+}
+
+// validateShipClassAvailable checks that a class is defined for this game.
+// When the config declares ship types only those may be flown; otherwise any built-in class is allowed.
+func (g *Game) validateShipClassAvailable(class entity.ShipClass) error {
+	name := class.String()
+	if name == "Unknown" {
+		return errors.New("unknown ship class")
+	}
+	if len(g.Config.ShipTypes) > 0 {
+		if _, ok := g.Config.ShipTypes[name]; !ok {
+			return errors.New("ship class not available in this game")
+		}
+	}
+	return nil
+}
+
+// validateShipClassTeamLimit checks the per-team limit for a ship class. A teammate
+// takes up a slot while flying the class or while waiting to switch to it on respawn.
+func (g *Game) validateShipClassTeamLimit(player *Player, class entity.ShipClass) error {
+	limit := g.shipClassTeamLimit(class)
+	if limit <= 0 {
+		return nil
+	}
+
+	team, ok := g.Teams[player.TeamID]
+	if !ok {
+		return errors.New("invalid team")
+	}
+
+	count := 0
+	for _, teammate := range team.Players {
+		if teammate.ID != player.ID && g.occupiesShipClass(teammate, class) {
+			count++
+		}
+	}
+	if count >= limit {
+		return errors.New("team has reached the limit for this ship class")
+	}
+	return nil
+}
+
+// occupiesShipClass reports whether a player's active ship is of a class, or the player
+// has asked to fly the class on their next respawn.
+func (g *Game) occupiesShipClass(player *Player, class entity.ShipClass) bool {
+	if player.ShipClass == class {
+		return true
+	}
+	ship, ok := g.Ships[player.ShipID]
+	return ok && ship.Active && ship.Class == class
+}
 
+// shipClassTeamLimit returns how many players per team may fly a class, 0 for unlimited.
+// A team may only ever have one starbase, whatever the config says.
+func (g *Game) shipClassTeamLimit(class entity.ShipClass) int {
+	if class == entity.Starbase {
+		return 1
+	}
+	return g.Config.ShipTypes[class.String()].MaxPerTeam
+}
+
+// validateShipClassKills checks that the player has made enough kills this session to fly a class.
+func (g *Game) validateShipClassKills(player *Player, class entity.ShipClass) error {
+	if player.Kills < g.shipClassMinKills(class) {
+		return errors.New("not enough kills to fly this ship class")
+	}
 	return nil
 }
 
+// shipClassMinKills returns the session kills needed to fly a class. A starbase
+// without a configured requirement falls back to defaultStarbaseMinKills.
+func (g *Game) shipClassMinKills(class entity.ShipClass) int {
+	minKills := g.Config.ShipTypes[class.String()].MinKills
+	if minKills <= 0 && class == entity.Starbase {
+		return defaultStarbaseMinKills
+	}
+	return minKills
+}
+
+// validateShipClassChangeLocation checks that the player is dead or orbiting a friendly
+// planet with a shipyard.
+func (g *Game) validateShipClassChangeLocation(player *Player) error {
+	ship, ok := g.Ships[player.ShipID]
+	if !ok || !ship.Active {
+		return nil // Dead players may pick their next ship freely
+	}
+	if g.isInFriendlyOrbit(ship) && g.Planets[ship.Orbiting].HasShipyard() {
+		return nil
+	}
+	return errors.New("ship class can only be changed while orbiting a friendly shipyard or dead")
+}
+
 // findPlayerByID finds a player by their ID.
 func (g *Game) findPlayerByID(playerID entity.ID) (*Player, error) {
 	for _, team := range g.Teams {
@@ -1203,6 +1739,9 @@ func (g *Game) BeamArmies(shipID, planetID entity.ID, direction string, amount i
 	g.EntityLock.Lock()
 	defer g.EntityLock.Unlock()
 
+	if err := g.checkNotPaused(); err != nil {
+		return 0, err
+	}
 	ship, planet, err := g.findShipAndPlanet(shipID, planetID)
 	if err != nil {
 		return 0, err
@@ -1245,8 +1784,11 @@ func (g *Game) validateBeamingPreconditions(ship *entity.Ship, planet *entity.Pl
 	if !ship.Active {
 		return errors.New("ship is not active")
 	}
-	if err := g.validateBeamingDistance(ship, planet); err != nil {
-		return err
+	if g.inLobby() {
+		return errors.New("armies cannot be beamed in the tournament lobby")
+	}
+	if ship.Orbiting != planet.ID {
+		return errors.New("ship must be orbiting the planet to beam armies")
 	}
 	if direction == "up" && ship.TeamID != planet.TeamID {
 		return errors.New("cannot beam up from an enemy planet")
@@ -1254,14 +1796,6 @@ func (g *Game) validateBeamingPreconditions(ship *entity.Ship, planet *entity.Pl
 	return nil
 }
 
-// validateBeamingDistance checks if a ship is close enough to a planet to beam armies.
-func (g *Game) validateBeamingDistance(ship *entity.Ship, planet *entity.Planet) error {
-	if ship.Position.Distance(planet.Position) > ship.Collider.Radius+planet.Collider.Radius+50 {
-		return errors.New("ship is too far from planet")
-	}
-	return nil
-}
-
 // beamArmiesDown handles beaming armies from a ship to a planet.
 func (g *Game) beamArmiesDown(ship *entity.Ship, planet *entity.Planet, amount int) (int, error) {
 	if ship.Armies <= 0 {
@@ -1296,7 +1830,7 @@ func (g *Game) handlePlanetCapture(ship *entity.Ship, planet *entity.Planet) {
 		team.PlanetCount++
 	}
 
-	if player, ok := g.findPlayerByShipID(ship.ID); ok {
+	if player, ok := g.findPlayerByShipID(ship.ID); ok && g.statsCount() {
 		player.Captures++
 		player.Score += 50 // Points for capture
 	}
@@ -1316,11 +1850,15 @@ func (g *Game) beamArmiesUp(ship *entity.Ship, planet *entity.Planet, amount int
 		return 0, errors.New("cannot beam up armies from enemy planet")
 	}
 
-	if ship.Armies >= ship.Stats.MaxArmies {
+	capacity := g.armyCapacity(ship)
+	if ship.Armies >= capacity {
+		if capacity < ship.Stats.MaxArmies {
+			return 0, errors.New("ship needs more kills to carry armies")
+		}
 		return 0, errors.New("ship is at maximum army capacity")
 	}
 
-	spaceAvailable := ship.Stats.MaxArmies - ship.Armies
+	spaceAvailable := capacity - ship.Armies
 	if amount > spaceAvailable {
 		amount = spaceAvailable
 	}
@@ -1331,6 +1869,30 @@ func (g *Game) beamArmiesUp(ship *entity.Ship, planet *entity.Planet, amount int
 	return transferred, nil
 }
 
+// defaultArmiesPerKill is the classic Netrek number of armies a ship may carry per kill
+const defaultArmiesPerKill = 2
+
+// armyCapacity returns how many armies a ship may carry. When GameRules.ArmiesRequireKills
+// is set this is limited by its pilot's kill streak; otherwise it is the class maximum.
+func (g *Game) armyCapacity(ship *entity.Ship) int {
+	if !g.Config.GameRules.ArmiesRequireKills {
+		return ship.Stats.MaxArmies
+	}
+	perKill := g.Config.GameRules.ArmiesPerKill
+	if perKill <= 0 {
+		perKill = defaultArmiesPerKill
+	}
+	return min(g.shipKillStreak(ship)*perKill, ship.Stats.MaxArmies)
+}
+
+// shipKillStreak returns the kill streak of the player flying a ship.
+func (g *Game) shipKillStreak(ship *entity.Ship) int {
+	if player, ok := g.findPlayerByShipID(ship.ID); ok {
+		return player.KillStreak
+	}
+	return 0
+}
+
 // FireWeapon fires a weapon from a ship
 func (g *Game) FireWeapon(shipID entity.ID, weaponIndex int) error {
 	g.EntityLock.Lock()
@@ -1340,8 +1902,48 @@ func (g *Game) FireWeapon(shipID entity.ID, weaponIndex int) error {
 	if err != nil {
 		return err
 	}
+	return g.fireShipWeapon(ship, weaponIndex, ship.Rotation)
+}
 
-	projectile := ship.FireWeapon(weaponIndex)
+// FireWeaponAt fires a weapon from a ship straight at a target ship rather than
+// along the ship's heading. Cloaked enemies can only be targeted within
+// CloakDetectionRadius.
+func (g *Game) FireWeaponAt(shipID entity.ID, weaponIndex int, targetID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	target, err := g.findActiveShip(targetID)
+	if err != nil {
+		return err
+	}
+	if !g.detects(ship, target) {
+		return errors.New("target is cloaked")
+	}
+	return g.fireShipWeapon(ship, weaponIndex, target.Position.Sub(ship.Position).Angle())
+}
+
+// fireShipWeapon fires a ship's weapon at the given angle. Hitscan weapons are resolved
+// immediately; other weapons launch a projectile.
+func (g *Game) fireShipWeapon(ship *entity.Ship, weaponIndex int, angle float64) error {
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	if ship.Cloaked {
+		return errors.New("cannot fire while cloaked")
+	}
+
+	if ship.IsHitscanWeapon(weaponIndex) {
+		if weapon, ok := ship.FireHitscan(weaponIndex); ok {
+			g.fireHitscan(ship, weapon, angle)
+		}
+		return nil // Weapon on cooldown or out of fuel otherwise
+	}
+
+	projectile := ship.FireWeaponAt(weaponIndex, angle)
 	if projectile == nil {
 		return nil // Weapon on cooldown or out of ammo
 	}
@@ -1350,6 +1952,13 @@ func (g *Game) FireWeapon(shipID entity.ID, weaponIndex int) error {
 	return nil
 }
 
+// detects reports whether a ship can see another: teammates and uncloaked ships always,
+// cloaked enemies only within CloakDetectionRadius.
+func (g *Game) detects(viewer, target *entity.Ship) bool {
+	return !target.Cloaked || target.TeamID == viewer.TeamID ||
+		viewer.Position.Distance(target.Position) <= CloakDetectionRadius
+}
+
 // findActiveShip finds a ship by ID and checks if it's active.
 func (g *Game) findActiveShip(shipID entity.ID) (*entity.Ship, error) {
 	ship, ok := g.Ships[shipID]
@@ -1381,19 +1990,37 @@ func (g *Game) findSpawnPoint(teamID int) physics.Vector2D {
 	return g.findRandomSpawnPoint()
 }
 
-// findSpawnPointNearHomeworld tries to find a spawn point near a team's planet.
+// findSpawnPointNearHomeworld tries to find a spawn point near a team's homeworld,
+// falling back to any planet the team owns.
 func (g *Game) findSpawnPointNearHomeworld(teamID int) (physics.Vector2D, bool) {
-	for _, planet := range g.Planets {
-		if planet.TeamID == teamID {
-			angle := rand.Float64() * 2 * math.Pi
-			distance := planet.Collider.Radius + 100 + rand.Float64()*100
-			return physics.Vector2D{
-				X: planet.Position.X + math.Cos(angle)*distance,
-				Y: planet.Position.Y + math.Sin(angle)*distance,
-			}, true
+	planet, ok := g.findTeamHomeworld(teamID)
+	if !ok {
+		return physics.Vector2D{}, false
+	}
+
+	angle := g.Rand.Float64() * 2 * math.Pi
+	distance := planet.Collider.Radius + 100 + g.Rand.Float64()*100
+	return physics.Vector2D{
+		X: planet.Position.X + math.Cos(angle)*distance,
+		Y: planet.Position.Y + math.Sin(angle)*distance,
+	}, true
+}
+
+// findTeamHomeworld returns the team's homeworld, or its first owned planet if the homeworld was lost.
+func (g *Game) findTeamHomeworld(teamID int) (*entity.Planet, bool) {
+	var fallback *entity.Planet
+	for _, planet := range g.planetsInOrder() {
+		if planet.TeamID != teamID {
+			continue
+		}
+		if planet.Type == entity.Homeworld {
+			return planet, true
+		}
+		if fallback == nil {
+			fallback = planet
 		}
 	}
-	return physics.Vector2D{}, false
+	return fallback, fallback != nil
 }
 
 // findRandomSpawnPoint returns a random position in the world.
@@ -1402,8 +2029,8 @@ func (g *Game) findRandomSpawnPoint() physics.Vector2D {
 	halfWorld := worldSize / 2
 
 	return physics.Vector2D{
-		X: rand.Float64()*worldSize - halfWorld,
-		Y: rand.Float64()*worldSize - halfWorld,
+		X: g.Rand.Float64()*worldSize - halfWorld,
+		Y: g.Rand.Float64()*worldSize - halfWorld,
 	}
 }
 
@@ -1418,11 +2045,19 @@ func (g *Game) GetGameState() *GameState {
 // createGameStateSnapshot builds and returns the complete game state.
 func (g *Game) createGameStateSnapshot() *GameState {
 	return &GameState{
-		Tick:        g.CurrentTick,
-		Ships:       g.getShipStates(),
-		Planets:     g.getPlanetStates(),
-		Projectiles: g.getProjectileStates(),
-		Teams:       g.getTeamStates(),
+		Tick:          g.CurrentTick,
+		Ships:         g.getShipStates(),
+		Planets:       g.getPlanetStates(),
+		Projectiles:   g.getProjectileStates(),
+		Teams:         g.getTeamStates(),
+		RespawnTimers: g.getRespawnTimers(),
+		PhaserBeams:   g.getPhaserBeams(),
+		Explosions:    g.getExplosions(),
+		Flags:         g.getFlagStates(),
+		Status:        g.Status,
+		Paused:        g.Paused,
+		Lobby:         g.getLobbyState(),
+		Results:       g.getMatchResults(),
 	}
 }
 
@@ -1442,6 +2077,19 @@ func (g *Game) getShipStates() map[entity.ID]ShipState {
 				Armies:   ship.Armies,
 				TeamID:   ship.TeamID,
 				Class:    ship.Class,
+				Cloaked:  ship.Cloaked,
+				Warping:  ship.Warping,
+				Orbiting: ship.Orbiting,
+				Kills:    g.shipKillStreak(ship),
+
+				ShieldsUp:         ship.ShieldsUp,
+				EngineTemp:        ship.EngineTemp,
+				EngineOverheated:  ship.EngineOverheated,
+				WeaponTemp:        ship.WeaponTemp,
+				WeaponsOverheated: ship.WeaponsOverheated,
+				Tractor:           ship.Tractor,
+				Pressor:           ship.Pressor,
+				DockedTo:          ship.DockedTo,
 			}
 		}
 	}
@@ -1483,6 +2131,7 @@ func (g *Game) getProjectileStates() map[entity.ID]ProjectileState {
 // getTeamStates creates a snapshot of the current team states.
 func (g *Game) getTeamStates() map[int]TeamState {
 	states := make(map[int]TeamState)
+	economies := g.getTeamEconomies()
 	for id, team := range g.Teams {
 		states[id] = TeamState{
 			ID:          id,
@@ -1491,6 +2140,10 @@ func (g *Game) getTeamStates() map[int]TeamState {
 			Score:       team.Score,
 			ShipCount:   team.ShipCount,
 			PlanetCount: team.PlanetCount,
+			Resources:   economies[id].resources,
+			Production:  economies[id].production,
+
+			FlagCaptures: team.FlagCaptures,
 		}
 	}
 	return states
@@ -1503,6 +2156,21 @@ type GameState struct {
 	Planets     map[entity.ID]PlanetState
 	Projectiles map[entity.ID]ProjectileState
 	Teams       map[int]TeamState
+	// RespawnTimers holds the seconds each dead player must still wait, keyed by player ID
+	RespawnTimers map[entity.ID]float64 `json:",omitempty"`
+	// PhaserBeams holds the phaser beams fired in the last phaserBeamDuration seconds
+	PhaserBeams []PhaserBeamState `json:",omitempty"`
+	// Explosions holds the ship explosions of the last explosionDuration seconds
+	Explosions []ExplosionState `json:",omitempty"`
+	// Flags holds the flags in capture the flag, keyed by flag ID
+	Flags  map[entity.ID]FlagState `json:",omitempty"`
+	Status GameStatus
+	// Paused reports that the game is frozen until an admin resumes it
+	Paused bool `json:",omitempty"`
+	// Lobby holds the tournament lobby while the game waits for players to ready up
+	Lobby *LobbyState `json:",omitempty"`
+	// Results holds the outcome of the finished match until the next one starts
+	Results *MatchResults `json:",omitempty"`
 }
 
 // ShipState represents a snapshot of a ship's state
@@ -1517,6 +2185,19 @@ type ShipState struct {
 	Armies   int
 	TeamID   int
 	Class    entity.ShipClass
+	Cloaked  bool
+	Warping  bool
+	Orbiting entity.ID // Planet the ship is orbiting, 0 if none
+	Kills    int       // Pilot's kill streak, which limits armies carried under ArmiesRequireKills
+
+	ShieldsUp         bool
+	EngineTemp        float64 // 0 to 100; thrust and warp shut down at 100 until it cools to 50
+	EngineOverheated  bool
+	WeaponTemp        float64 // 0 to 100; weapons lock out at 100 until they cool to 50
+	WeaponsOverheated bool
+	Tractor           entity.ID // Ship or planet held by a tractor or pressor beam, 0 if none
+	Pressor           bool      // The beam on Tractor pushes rather than pulls
+	DockedTo          entity.ID // Starbase the ship is docked with, 0 if none
 }
 
 // PlanetState represents a snapshot of a planet's state
@@ -1545,6 +2226,10 @@ type TeamState struct {
 	Score       int
 	ShipCount   int
 	PlanetCount int
+	Resources   int // Total resources of the team's planets
+	Production  int // Total army production of the team's planets
+	// FlagCaptures counts the enemy flags the team has captured in capture the flag
+	FlagCaptures int `json:",omitempty"`
 }
 
 // registerEventHandlers registers handlers for game events
@@ -1554,7 +2239,7 @@ func (g *Game) registerEventHandlers() {
 
 // handleShipDestroyedEvent handles the logic when a ship is destroyed.
 func (g *Game) handleShipDestroyedEvent(e event.Event) {
-	if _, ok := e.(*event.ShipEvent); !ok {
+	if _, ok := e.(*event.ShipEvent); !ok || g.inLobby() {
 		return
 	}
 
@@ -1574,7 +2259,8 @@ func (g *Game) endGameWithWinner() {
 	}
 
 	g.Status = GameStatusEnded
-	g.EndTime = time.Now()
+	g.EndTime = g.Clock.Now()
+	g.endTick = g.CurrentTick
 
 	winnerID := -1
 	maxPlanets := 0
@@ -1615,7 +2301,8 @@ func (g *Game) endGameInternal() {
 		return
 	}
 	g.Status = GameStatusEnded
-	g.EndTime = time.Now()
+	g.EndTime = g.Clock.Now()
+	g.endTick = g.CurrentTick
 	g.Running = false
 
 	winnerID := g.determineWinner()
@@ -1645,9 +2332,12 @@ func (g *Game) calculateWinnerByDefaultRules() int {
 
 	for id, team := range g.Teams {
 		currentScore := 0
-		if g.Config.GameRules.WinCondition == "conquest" {
+		switch g.Config.GameRules.WinCondition {
+		case "conquest":
 			currentScore = team.PlanetCount
-		} else {
+		case "ctf":
+			currentScore = team.FlagCaptures
+		default:
 			currentScore = team.Score
 		}
 
@@ -3,7 +3,6 @@ package engine
 
 import (
 	"testing"
-	"time"
 
 	"github.com/opd-ai/go-netrek/pkg/config"
 	"github.com/opd-ai/go-netrek/pkg/entity"
@@ -20,6 +19,50 @@ func defaultConfig() *config.GameConfig {
 	}
 }
 
+// wideConfig returns the default config in a 10000 unit world, leaving room to park
+// ships well away from the homeworld.
+func wideConfig() *config.GameConfig {
+	cfg := defaultConfig()
+	cfg.WorldSize = 10000
+	return cfg
+}
+
+// addShips adds a player to each of the given teams and returns their ships in order.
+func addShips(t *testing.T, game *Game, teams ...int) []*entity.Ship {
+	ships := make([]*entity.Ship, 0, len(teams))
+	for _, teamID := range teams {
+		id, err := game.AddPlayer("pilot", teamID)
+		if err != nil {
+			t.Fatalf("AddPlayer failed: %v", err)
+		}
+		player, _ := game.findPlayerByID(id)
+		ships = append(ships, game.Ships[player.ShipID])
+	}
+	return ships
+}
+
+// shipPlayer returns the player flying a ship.
+func shipPlayer(game *Game, ship *entity.Ship) *Player {
+	player, _ := game.findPlayerByShipID(ship.ID)
+	return player
+}
+
+// moveShip puts a ship at rest at the given position.
+func moveShip(ship *entity.Ship, pos physics.Vector2D) {
+	ship.Position = pos
+	ship.Collider.Center = pos
+	ship.Velocity = physics.Vector2D{}
+}
+
+// lineUpShips parks ships at rest with their shields down in a row along the X axis,
+// spacing units apart starting at start.
+func lineUpShips(ships []*entity.Ship, start physics.Vector2D, spacing float64) {
+	for i, ship := range ships {
+		moveShip(ship, start.Add(physics.Vector2D{X: float64(i) * spacing}))
+		ship.Shields = 0
+	}
+}
+
 func TestNewGame_InitializesState(t *testing.T) {
 	cfg := defaultConfig()
 	game := NewGame(cfg)
@@ -112,6 +155,14 @@ func TestGame_BeamArmies_Scenarios(t *testing.T) {
 	planet.TeamID = 0
 	planet.Armies = 10
 
+	// Error: not in orbit
+	if _, err := game.BeamArmies(ship.ID, planet.ID, "down", 3); err == nil {
+		t.Error("expected error when not orbiting the planet")
+	}
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+
 	// Success: beam down
 	trans, err := game.BeamArmies(ship.ID, planet.ID, "down", 3)
 	if err != nil || trans != 3 {
@@ -166,8 +217,9 @@ func TestGame_Update_EndsOnTimeLimit(t *testing.T) {
 	cfg.GameRules.TimeLimit = 1 // 1 second
 	game := NewGame(cfg)
 	game.Start()
-	game.StartTime = game.StartTime.Add(-2 * time.Second) // simulate time passage
-	game.Update()
+	for i := 0; i < 61; i++ { // one second of ticks plus one
+		game.Update()
+	}
 	if game.Status != GameStatusEnded {
 		t.Error("game should end when time limit is reached")
 	}
@@ -191,7 +243,7 @@ func TestGame_wrapEntityPosition_WrapsCorrectly(t *testing.T) {
 	ship := game.Ships[game.Teams[0].Players[pid].ShipID]
 	world := game.Config.WorldSize / 2
 	ship.Position = physics.Vector2D{X: world + 10, Y: world + 10}
-	game.wrapEntityPosition(ship)
+	game.wrapEntityPosition(ship, game.shipsInOrder())
 	if ship.Position.X > world || ship.Position.Y > world {
 		t.Error("ship position not wrapped correctly")
 	}
@@ -209,12 +261,24 @@ func TestGame_WrapEntityPosition_OverlapEdgeCase(t *testing.T) {
 	ship1.Position = physics.Vector2D{X: world + 10, Y: 0}
 	ship2.Position = physics.Vector2D{X: -world, Y: 0}
 	// Wrap ship1, should now overlap ship2
-	game.wrapEntityPosition(ship1)
+	game.wrapEntityPosition(ship1, game.shipsInOrder())
 	if ship1.Position.Distance(ship2.Position) < ship1.Collider.Radius+ship2.Collider.Radius {
 		t.Errorf("Ships overlap after wrapping: ship1=%v ship2=%v", ship1.Position, ship2.Position)
 	}
 }
 
+func TestGame_WrapEntityPosition_ProjectileReachesShip(t *testing.T) {
+	game := NewGame(defaultConfig())
+	pid, _ := game.AddPlayer("Target", 0)
+	ship := game.Ships[game.Teams[0].Players[pid].ShipID]
+	proj := &entity.Projectile{BaseEntity: entity.BaseEntity{Position: ship.Position, Collider: physics.Circle{Radius: 5}}}
+
+	game.wrapEntityPosition(proj, game.shipsInOrder())
+	if proj.Position != ship.Position {
+		t.Errorf("projectile nudged off the ship it overlaps to %v; it could never hit", proj.Position)
+	}
+}
+
 func TestGame_cleanupInactiveEntities_RemovesProjectiles(t *testing.T) {
 	game := NewGame(defaultConfig())
 	proj := &entity.Projectile{BaseEntity: entity.BaseEntity{ID: 42, Active: false}}
@@ -290,3 +354,147 @@ func TestGame_ShipClassSelectionFromConfig(t *testing.T) {
 		t.Errorf("respawn: expected Destroyer, got %v", ship0r.Class)
 	}
 }
+
+func TestGame_RequestShipClass_Rules(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.ShipTypes = map[string]config.ShipTypeConfig{
+		"Scout":     {Name: "Scout"},
+		"Destroyer": {Name: "Destroyer", MaxPerTeam: 1},
+	}
+	game := NewGame(cfg)
+	id1, _ := game.AddPlayer("One", 0)
+	id2, _ := game.AddPlayer("Two", 0)
+	ship1 := game.Ships[game.Teams[0].Players[id1].ShipID]
+
+	// Out in open space with an active ship: rejected
+	ship1.Position = physics.Vector2D{X: 400, Y: 400}
+	if err := game.RequestShipClass(id1, entity.Destroyer); err == nil {
+		t.Error("expected rejection when not in orbit")
+	}
+
+	// Class not listed in ShipTypes: rejected
+	ship1.Active = false
+	if err := game.RequestShipClass(id1, entity.Battleship); err == nil {
+		t.Error("expected rejection for class missing from config")
+	}
+
+	// Dead player picking an allowed class: accepted and applied on respawn
+	if err := game.RequestShipClass(id1, entity.Destroyer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := game.RespawnShip(id1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := game.Ships[game.Teams[0].Players[id1].ShipID].Class; got != entity.Destroyer {
+		t.Errorf("expected Destroyer after respawn, got %v", got)
+	}
+
+	// Second Destroyer on the same team exceeds MaxPerTeam
+	ship2 := game.Ships[game.Teams[0].Players[id2].ShipID]
+	for _, planet := range game.Planets {
+		ship2.Position = planet.Position
+	}
+	if err := game.Orbit(ship2.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	if err := game.RequestShipClass(id2, entity.Destroyer); err == nil {
+		t.Error("expected rejection when team limit reached")
+	}
+
+	// Orbiting the friendly homeworld: accepted
+	if err := game.RequestShipClass(id2, entity.Scout); err != nil {
+		t.Errorf("unexpected error while in orbit: %v", err)
+	}
+}
+
+func TestGame_RequestShipClass_TeamLimitCountsFlownClass(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.ShipTypes = map[string]config.ShipTypeConfig{
+		"Scout":     {Name: "Scout"},
+		"Destroyer": {Name: "Destroyer", MaxPerTeam: 1},
+	}
+	game := NewGame(cfg)
+	id1, _ := game.AddPlayer("One", 0)
+	id2, _ := game.AddPlayer("Two", 0)
+	player1, _ := game.findPlayerByID(id1)
+	player2, _ := game.findPlayerByID(id2)
+	game.Ships[player2.ShipID].Active = false
+
+	// A teammate still flying a Destroyer takes the slot even after queueing a Scout
+	game.Ships[player1.ShipID].Class = entity.Destroyer
+	player1.ShipClass = entity.Scout
+	if err := game.RequestShipClass(id2, entity.Destroyer); err == nil {
+		t.Error("expected rejection while a teammate flies the class")
+	}
+
+	// Once that teammate's ship is gone, only the queued Scout counts
+	game.Ships[player1.ShipID].Active = false
+	if err := game.RequestShipClass(id2, entity.Destroyer); err != nil {
+		t.Errorf("unexpected error once the class is free: %v", err)
+	}
+}
+
+func TestGame_BeamArmiesUp_KillGated(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.GameRules.ArmiesRequireKills = true
+	cfg.GameRules.ArmiesPerKill = 2
+	game := NewGame(cfg)
+	pid, _ := game.AddPlayer("Bomber", 0)
+	enemyID, _ := game.AddPlayer("Target", 1)
+	player, _ := game.findPlayerByID(pid)
+	enemy, _ := game.findPlayerByID(enemyID)
+
+	var earth *entity.Planet
+	for _, planet := range game.Planets {
+		earth = planet
+	}
+	earth.Armies = 20
+	ship := game.Ships[player.ShipID]
+	ship.Stats.MaxArmies = 10
+	ship.Position = earth.Position
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+
+	// No kills yet: nothing can be carried
+	if _, err := game.BeamArmies(ship.ID, earth.ID, "up", 5); err == nil {
+		t.Error("expected beam up to fail without kills")
+	}
+
+	// One kill allows two armies
+	game.handleShipDestruction(game.Ships[enemy.ShipID], ship.ID, ship.TeamID)
+	if trans, err := game.BeamArmies(ship.ID, earth.ID, "up", 5); err != nil || trans != 2 {
+		t.Errorf("beam up after one kill = %d, %v; want 2", trans, err)
+	}
+	if state := game.GetGameState().Ships[ship.ID]; state.Kills != 1 {
+		t.Errorf("ship state kills = %d, want 1", state.Kills)
+	}
+
+	// Dying resets the streak
+	game.handleShipDestruction(ship, 0, -1)
+	if player.KillStreak != 0 {
+		t.Errorf("kill streak after death = %d, want 0", player.KillStreak)
+	}
+}
+
+func TestGame_BeamArmiesUp_WithoutKillRule(t *testing.T) {
+	game := NewGame(defaultConfig())
+	pid, _ := game.AddPlayer("Casual", 0)
+	player, _ := game.findPlayerByID(pid)
+
+	var earth *entity.Planet
+	for _, planet := range game.Planets {
+		earth = planet
+	}
+	earth.Armies = 20
+	ship := game.Ships[player.ShipID]
+	ship.Position = earth.Position
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+
+	want := ship.Stats.MaxArmies
+	if trans, err := game.BeamArmies(ship.ID, earth.ID, "up", want); err != nil || trans != want {
+		t.Errorf("beam up without the kill rule = %d, %v; want %d", trans, err, want)
+	}
+}
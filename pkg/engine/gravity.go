@@ -0,0 +1,83 @@
+// pkg/engine/gravity.go
+package engine
+
+import (
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+const (
+	// gravityRange is the distance beyond which a planet's pull is ignored
+	gravityRange = 1500.0
+	// maxGravityAcceleration is the soft cap on the pull from all planets, in units/s²
+	maxGravityAcceleration = 60.0
+	// shipGravityFraction caps the pull on a ship at this fraction of its base
+	// acceleration, so even the slowest ship can thrust its way out of a gravity well
+	shipGravityFraction = 0.5
+)
+
+// applyGravity accelerates a velocity towards nearby planets for one tick.
+// Note: Called from within locked context in Update()
+func (g *Game) applyGravity(velocity *physics.Vector2D, position physics.Vector2D, deltaTime float64) {
+	if g.Config.PhysicsConfig.Gravity == 0 {
+		return
+	}
+	accel := g.gravityAt(position)
+	*velocity = velocity.Add(accel.Scale(deltaTime))
+}
+
+// applyShipGravity accelerates a ship towards nearby planets for one tick, never
+// pulling harder than shipGravityFraction of the ship's own acceleration.
+// Note: Called from within locked context in Update()
+func (g *Game) applyShipGravity(ship *entity.Ship, deltaTime float64) {
+	if g.Config.PhysicsConfig.Gravity == 0 {
+		return
+	}
+	accel := g.gravityAt(ship.Position)
+	if limit := ship.Stats.Acceleration * shipGravityFraction; accel.Length() > limit {
+		accel = accel.Normalize().Scale(limit)
+	}
+	ship.Velocity = ship.Velocity.Add(accel.Scale(deltaTime))
+}
+
+// gravityAt returns the soft-capped gravitational acceleration at a point.
+// Each planet within gravityRange pulls with Gravity * mass / distance²,
+// measured from no closer than the planet's surface.
+func (g *Game) gravityAt(position physics.Vector2D) physics.Vector2D {
+	area := physics.Rect{
+		Center: position,
+		Width:  gravityRange * 2,
+		Height: gravityRange * 2,
+	}
+
+	var total physics.Vector2D
+	for _, other := range g.SpatialIndex.Query(area) {
+		planet, ok := other.(*entity.Planet)
+		if !ok {
+			continue
+		}
+		offset := planet.Position.Sub(position)
+		distance := offset.Length()
+		if distance > gravityRange || distance == 0 {
+			continue
+		}
+		if distance < planet.Collider.Radius {
+			distance = planet.Collider.Radius
+		}
+		strength := g.Config.PhysicsConfig.Gravity * planet.Mass() / (distance * distance)
+		total = total.Add(offset.Normalize().Scale(strength))
+	}
+
+	return softCapGravity(total)
+}
+
+// softCapGravity smoothly limits an acceleration so it approaches but never exceeds
+// maxGravityAcceleration, leaving weak pulls almost unchanged.
+func softCapGravity(accel physics.Vector2D) physics.Vector2D {
+	magnitude := accel.Length()
+	if magnitude == 0 {
+		return accel
+	}
+	capped := magnitude * maxGravityAcceleration / (magnitude + maxGravityAcceleration)
+	return accel.Scale(capped / magnitude)
+}
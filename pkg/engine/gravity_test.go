@@ -0,0 +1,107 @@
+// Package engine provides unit tests for gravity.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// newGravityTestGame creates a game with a single homeworld at the origin.
+func newGravityTestGame(gravity float64) *Game {
+	cfg := defaultConfig()
+	cfg.PhysicsConfig.Gravity = gravity
+	game := NewGame(cfg)
+	game.prepareSpatialIndex()
+	return game
+}
+
+func TestGame_gravityAt_PullsTowardsPlanet(t *testing.T) {
+	game := newGravityTestGame(1)
+
+	accel := game.gravityAt(physics.Vector2D{X: 300, Y: 0})
+	if accel.X >= 0 || accel.Y != 0 {
+		t.Errorf("expected pull towards the planet along -X, got %v", accel)
+	}
+
+	near := game.gravityAt(physics.Vector2D{X: 200, Y: 0}).Length()
+	far := game.gravityAt(physics.Vector2D{X: 400, Y: 0}).Length()
+	if near <= far {
+		t.Errorf("gravity should weaken with distance: near %.3f, far %.3f", near, far)
+	}
+
+	if out := game.gravityAt(physics.Vector2D{X: gravityRange + 100, Y: 0}); out.Length() != 0 {
+		t.Errorf("expected no gravity beyond gravityRange, got %v", out)
+	}
+}
+
+func TestGame_gravityAt_SoftCapped(t *testing.T) {
+	game := newGravityTestGame(1000)
+
+	accel := game.gravityAt(physics.Vector2D{X: 60, Y: 0}).Length()
+	if accel >= maxGravityAcceleration {
+		t.Errorf("gravity %.2f should stay below the cap %.2f", accel, maxGravityAcceleration)
+	}
+	if accel < maxGravityAcceleration*0.9 {
+		t.Errorf("strong gravity %.2f should approach the cap %.2f", accel, maxGravityAcceleration)
+	}
+}
+
+func TestGame_gravityAt_ScalesWithPlanetType(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.PhysicsConfig.Gravity = 1
+	cfg.Planets = []config.PlanetConfig{
+		{Name: "Farm", X: -2000, Y: 0, Type: entity.Agricultural, TeamID: -1},
+		{Name: "Home", X: 2000, Y: 0, Type: entity.Homeworld, TeamID: -1},
+	}
+	cfg.WorldSize = 10000
+	game := NewGame(cfg)
+	game.prepareSpatialIndex()
+
+	farm := game.gravityAt(physics.Vector2D{X: -1700, Y: 0}).Length()
+	home := game.gravityAt(physics.Vector2D{X: 1700, Y: 0}).Length()
+	if home <= farm {
+		t.Errorf("homeworld pull %.3f should exceed agricultural pull %.3f", home, farm)
+	}
+}
+
+func TestGame_Update_GravityBendsProjectiles(t *testing.T) {
+	game := newGravityTestGame(5)
+	proj := entity.NewTorpedo(0).CreateProjectile(0, physics.Vector2D{X: -400, Y: 200}, 0, 1)
+	game.Projectiles[proj.ID] = proj
+
+	game.Update()
+
+	if proj.Velocity.Y >= 0 {
+		t.Errorf("torpedo passing above the planet should curve towards it, velocity %v", proj.Velocity)
+	}
+}
+
+func TestGame_Update_NoGravityByDefault(t *testing.T) {
+	game := newGravityTestGame(0)
+	proj := entity.NewTorpedo(0).CreateProjectile(0, physics.Vector2D{X: -400, Y: 200}, 0, 1)
+	game.Projectiles[proj.ID] = proj
+
+	game.Update()
+
+	if proj.Velocity.Y != 0 {
+		t.Errorf("torpedo should fly straight with gravity 0, velocity %v", proj.Velocity)
+	}
+}
+
+func TestGame_applyShipGravity_StarbaseCanEscape(t *testing.T) {
+	game := newGravityTestGame(1000)
+	base := entity.NewShip(entity.GenerateID(), entity.Starbase, 0, physics.Vector2D{X: 60, Y: 0})
+
+	game.applyShipGravity(base, 1)
+
+	pull := base.Velocity.Length()
+	if pull == 0 {
+		t.Fatal("the planet should pull on the starbase")
+	}
+	if pull >= base.Stats.Acceleration {
+		t.Errorf("gravity %.2f should stay below the starbase's acceleration %.2f", pull, base.Stats.Acceleration)
+	}
+}
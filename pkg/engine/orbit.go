@@ -0,0 +1,133 @@
+// pkg/engine/orbit.go
+package engine
+
+import (
+	"errors"
+	"math"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+const (
+	// orbitEntryRange is how far beyond a planet's surface a ship can be to enter orbit
+	orbitEntryRange = 150.0
+	// orbitMaxSpeed is the fastest a ship can be moving to lock into orbit, in units/s
+	orbitMaxSpeed = 60.0
+	// orbitAltitude is the gap kept between the ship's hull and the planet's surface
+	orbitAltitude = 30.0
+	// orbitAngularSpeed is how fast an orbiting ship circles the planet, in radians/s
+	orbitAngularSpeed = 0.5
+	// orbitRefuelRate is the fuel gained per second while orbiting a friendly planet
+	orbitRefuelRate = 50.0
+)
+
+// Orbit locks a ship into a circular orbit around the nearest planet in range.
+// The ship must be moving slower than orbitMaxSpeed; thrusting or warping breaks orbit.
+func (g *Game) Orbit(shipID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	if ship.Orbiting != 0 {
+		return nil // Already in orbit
+	}
+	if ship.DockedTo != 0 {
+		return errors.New("ship must undock before entering orbit")
+	}
+	if ship.Velocity.Length() > orbitMaxSpeed {
+		return errors.New("ship is moving too fast to orbit")
+	}
+
+	planet, ok := g.findOrbitablePlanet(ship)
+	if !ok {
+		return errors.New("no planet in orbit range")
+	}
+
+	ship.Orbiting = planet.ID
+	ship.Velocity = physics.Vector2D{}
+	g.holdOrbit(ship, planet)
+	return nil
+}
+
+// findOrbitablePlanet returns the nearest planet whose surface is within orbitEntryRange of the ship.
+func (g *Game) findOrbitablePlanet(ship *entity.Ship) (*entity.Planet, bool) {
+	var nearest *entity.Planet
+	nearestGap := math.Inf(1)
+	for _, planet := range g.planetsInOrder() {
+		gap := ship.Position.Distance(planet.Position) - planet.Collider.Radius
+		if gap <= orbitEntryRange && gap < nearestGap {
+			nearest, nearestGap = planet, gap
+		}
+	}
+	return nearest, nearest != nil
+}
+
+// isInFriendlyOrbit reports whether a ship is orbiting a planet owned by its team.
+func (g *Game) isInFriendlyOrbit(ship *entity.Ship) bool {
+	planet, ok := g.Planets[ship.Orbiting]
+	return ok && planet.TeamID == ship.TeamID
+}
+
+// breakOrbitIfManeuvering releases a ship from orbit when it thrusts or warps.
+// Note: Called from within locked context in Update()
+func (g *Game) breakOrbitIfManeuvering(ship *entity.Ship) {
+	if ship.Orbiting != 0 && (ship.Thrusting || ship.Warping) {
+		g.leaveOrbit(ship)
+	}
+}
+
+// leaveOrbit releases a ship from orbit and stops any orbital repairs.
+func (g *Game) leaveOrbit(ship *entity.Ship) {
+	ship.Orbiting = 0
+	ship.RepairMode = false
+}
+
+// updateOrbit keeps an orbiting ship on its circle and services it at friendly planets:
+// every friendly planet refuels, and those with a shipyard also repair.
+// Note: Called from within locked context in Update()
+func (g *Game) updateOrbit(ship *entity.Ship, deltaTime float64) {
+	if ship.Orbiting == 0 {
+		return
+	}
+	planet, ok := g.Planets[ship.Orbiting]
+	if !ok {
+		g.leaveOrbit(ship)
+		return
+	}
+
+	g.holdOrbit(ship, planet)
+
+	if planet.TeamID == ship.TeamID {
+		ship.Refuel(orbitRefuelRate, deltaTime)
+	}
+	if planet.TeamID == ship.TeamID && planet.HasShipyard() {
+		ship.RepairMode = ship.Hull < ship.Stats.MaxHull
+		ship.RepairTick(deltaTime)
+	} else {
+		ship.RepairMode = false
+	}
+}
+
+// holdOrbit snaps a ship onto its orbital circle at its current bearing from the planet
+// and sets its velocity and heading along the orbit. The ship's own movement during
+// the tick carries it forward around the circle.
+func (g *Game) holdOrbit(ship *entity.Ship, planet *entity.Planet) {
+	radius := planet.Collider.Radius + ship.Collider.Radius + orbitAltitude
+	offset := ship.Position.Sub(planet.Position)
+	angle := math.Atan2(offset.Y, offset.X)
+
+	radial := physics.Vector2D{X: math.Cos(angle), Y: math.Sin(angle)}
+	tangent := physics.Vector2D{X: -radial.Y, Y: radial.X}
+
+	ship.Position = planet.Position.Add(radial.Scale(radius))
+	ship.Collider.Center = ship.Position
+	ship.Velocity = tangent.Scale(orbitAngularSpeed * radius)
+	ship.Rotation = math.Atan2(tangent.Y, tangent.X)
+}
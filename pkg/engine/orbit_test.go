@@ -0,0 +1,133 @@
+// Package engine provides unit tests for orbit.go
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// parkAtEarth parks a ship at rest just outside Earth, the team 0 homeworld at the
+// origin, and returns Earth. Every other ship is moved out of the way and beyond planet
+// defense range, as ships otherwise spawn at random and can bump into the ship under test.
+func parkAtEarth(game *Game, ship *entity.Ship) *entity.Planet {
+	earth := game.planetsInOrder()[0]
+	for _, other := range game.shipsInOrder() {
+		moveShip(other, physics.Vector2D{X: 450, Y: -450})
+	}
+	moveShip(ship, physics.Vector2D{X: earth.Collider.Radius + 100, Y: 0})
+	return earth
+}
+
+func TestGame_Orbit_Preconditions(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[0]
+	parkAtEarth(game, ship)
+
+	ship.Velocity = physics.Vector2D{X: orbitMaxSpeed + 1}
+	if err := game.Orbit(ship.ID); err == nil {
+		t.Error("expected error when moving too fast to orbit")
+	}
+
+	ship.Velocity = physics.Vector2D{}
+	ship.Position = physics.Vector2D{X: 2000, Y: 2000}
+	if err := game.Orbit(ship.ID); err == nil {
+		t.Error("expected error with no planet in range")
+	}
+	if ship.Orbiting != 0 {
+		t.Error("failed orbit attempts should leave the ship free")
+	}
+}
+
+func TestGame_Orbit_CirclesPlanet(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[0]
+	earth := parkAtEarth(game, ship)
+
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	if ship.Orbiting != earth.ID {
+		t.Fatalf("expected ship to orbit %d, got %d", earth.ID, ship.Orbiting)
+	}
+
+	radius := ship.Position.Distance(earth.Position)
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	if d := ship.Position.Distance(earth.Position); math.Abs(d-radius) > 1 {
+		t.Errorf("orbit radius drifted from %.1f to %.1f", radius, d)
+	}
+	offset := ship.Position.Sub(earth.Position)
+	if angle := math.Atan2(offset.Y, offset.X); math.Abs(angle-orbitAngularSpeed) > 0.05 {
+		t.Errorf("expected ship to advance %.2f rad in one second, got %.2f", orbitAngularSpeed, angle)
+	}
+	if state := game.GetGameState().Ships[ship.ID]; state.Orbiting != earth.ID {
+		t.Errorf("ship state should report orbiting %d, got %d", earth.ID, state.Orbiting)
+	}
+}
+
+func TestGame_Orbit_FriendlyPlanetServicesShip(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[0]
+	parkAtEarth(game, ship)
+	ship.Fuel = 100
+	ship.Hull = ship.Stats.MaxHull / 2
+	hull := ship.Hull
+
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	if ship.Fuel <= 100 {
+		t.Errorf("friendly orbit should refuel the ship, fuel %d", ship.Fuel)
+	}
+	if ship.Hull <= hull {
+		t.Errorf("friendly orbit should repair the hull, hull %d", ship.Hull)
+	}
+}
+
+func TestGame_Orbit_EnemyPlanetDoesNotService(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[1]
+	parkAtEarth(game, ship)
+	ship.Fuel = 100
+	ship.Hull = ship.Stats.MaxHull / 2
+	hull := ship.Hull
+
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	if ship.Fuel > 100 || ship.Hull != hull {
+		t.Errorf("enemy orbit should not service the ship, fuel %d hull %d", ship.Fuel, ship.Hull)
+	}
+}
+
+func TestGame_Orbit_ThrustBreaksOrbit(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[0]
+	earth := parkAtEarth(game, ship)
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+
+	ship.Thrusting = true
+	game.Update()
+
+	if ship.Orbiting != 0 {
+		t.Error("thrusting should break orbit")
+	}
+	if _, err := game.BeamArmies(ship.ID, earth.ID, "up", 1); err == nil {
+		t.Error("expected beaming to fail after leaving orbit")
+	}
+}
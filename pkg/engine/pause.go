@@ -0,0 +1,68 @@
+// pkg/engine/pause.go
+package engine
+
+import (
+	"errors"
+
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/sirupsen/logrus"
+)
+
+// Pause freezes the game. While paused no ticks are simulated, so the match clock,
+// time limit, weapon cooldowns and respawn timers all hold until Resume is called.
+// Ships cannot fire, detonate torpedoes, beam armies, orbit, dock, use tractor beams
+// or change class, and players cannot ready up, while the game is paused.
+func (g *Game) Pause() error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if !g.Running {
+		return errors.New("game is not running")
+	}
+	if g.Paused {
+		return errors.New("game is already paused")
+	}
+
+	g.Paused = true
+	g.logger.WithField("caller", getCallerInfo()).WithFields(logrus.Fields{
+		"function":     "Pause",
+		"current_tick": g.CurrentTick,
+		"elapsed_time": g.ElapsedTime,
+	}).Info("Game paused")
+
+	g.EventBus.Publish(&event.BaseEvent{
+		EventType: event.GamePaused,
+		Source:    g,
+	})
+	return nil
+}
+
+// checkNotPaused returns an error if the game is paused, for actions that change it.
+func (g *Game) checkNotPaused() error {
+	if g.Paused {
+		return errors.New("game is paused")
+	}
+	return nil
+}
+
+// Resume continues a paused game from the tick it was paused on.
+func (g *Game) Resume() error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if !g.Paused {
+		return errors.New("game is not paused")
+	}
+
+	g.Paused = false
+	g.logger.WithField("caller", getCallerInfo()).WithFields(logrus.Fields{
+		"function":     "Resume",
+		"current_tick": g.CurrentTick,
+	}).Info("Game resumed")
+
+	g.EventBus.Publish(&event.BaseEvent{
+		EventType: event.GameResumed,
+		Source:    g,
+	})
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package engine provides unit tests for pause.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+func TestGame_Pause_FreezesSimulation(t *testing.T) {
+	game, player := newRespawnTestGame(t, 2, false)
+	game.Start()
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	ship := game.Ships[player.ShipID]
+	ship.Cooldowns["Torpedo"] = 1
+	ship.Velocity.X = 100
+	tick, elapsed, x := game.CurrentTick, game.ElapsedTime, ship.Position.X
+
+	if err := game.Pause(); err != nil {
+		t.Fatalf("Pause failed: %v", err)
+	}
+	if err := game.Pause(); err == nil {
+		t.Error("expected error pausing a paused game")
+	}
+	for i := 0; i < 600; i++ {
+		game.Update()
+	}
+
+	if game.CurrentTick != tick || game.ElapsedTime != elapsed {
+		t.Errorf("ticks and match clock should hold while paused, tick %d -> %d", tick, game.CurrentTick)
+	}
+	if ship.Position.X != x || ship.Cooldowns["Torpedo"] != 1 {
+		t.Error("ships and cooldowns should not change while paused")
+	}
+	if err := game.FireWeapon(ship.ID, 0); err == nil {
+		t.Error("expected error firing while paused")
+	}
+	if state := game.GetGameState(); !state.Paused {
+		t.Error("game state should report the game as paused")
+	}
+
+	if err := game.Resume(); err != nil {
+		t.Fatalf("Resume failed: %v", err)
+	}
+	game.Update()
+	if game.CurrentTick != tick+1 || ship.Position.X == x {
+		t.Error("the game should carry on from where it was paused")
+	}
+	if game.GetGameState().Paused {
+		t.Error("game state should no longer report the game as paused")
+	}
+}
+
+func TestGame_Pause_HoldsTimeLimitAndRespawns(t *testing.T) {
+	game, player := newRespawnTestGame(t, 1, false)
+	game.Config.GameRules.TimeLimit = 1
+	game.Start()
+
+	var published []event.Type
+	for _, eventType := range []event.Type{event.GamePaused, event.GameResumed} {
+		game.EventBus.Subscribe(eventType, func(e event.Event) {
+			published = append(published, e.GetType())
+		})
+	}
+
+	oldShipID := destroyPlayerShip(game, player)
+	game.Pause()
+	for i := 0; i < 300; i++ {
+		game.Update()
+	}
+	if game.Status != GameStatusActive || player.ShipID != oldShipID {
+		t.Fatal("the time limit and respawn delay should not run out while paused")
+	}
+
+	game.Resume()
+	if err := game.Resume(); err == nil {
+		t.Error("expected error resuming a game that is not paused")
+	}
+	for i := 0; i < 61; i++ {
+		game.Update()
+	}
+	if player.ShipID == oldShipID {
+		t.Error("the ship should respawn once the game has resumed")
+	}
+	if len(published) != 2 || published[0] != event.GamePaused || published[1] != event.GameResumed {
+		t.Errorf("expected paused then resumed events, got %v", published)
+	}
+}
+
+func TestGame_Pause_BlocksOrbitAndBeaming(t *testing.T) {
+	game := NewGame(defaultConfig())
+	ship := addShips(t, game, 0, 1)[0]
+	earth := parkAtEarth(game, ship)
+	game.Start()
+	game.Pause()
+
+	if err := game.Orbit(ship.ID); err == nil || ship.Orbiting != 0 {
+		t.Error("expected orbiting to fail while paused")
+	}
+
+	game.Resume()
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	game.Pause()
+
+	armies := earth.Armies
+	if _, err := game.BeamArmies(ship.ID, earth.ID, "up", 2); err == nil {
+		t.Error("expected beaming to fail while paused")
+	}
+	if earth.Armies != armies || ship.Armies != 0 {
+		t.Error("no armies should move while paused")
+	}
+
+	game.Resume()
+	if trans, err := game.BeamArmies(ship.ID, earth.ID, "up", 2); err != nil || trans != 2 {
+		t.Errorf("beam up after resuming = %d, %v; want 2", trans, err)
+	}
+}
@@ -0,0 +1,114 @@
+// pkg/engine/phaser.go
+package engine
+
+import (
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// phaserBeamDuration is how long, in seconds, a fired phaser beam stays in the game state
+const phaserBeamDuration = 0.2
+
+// PhaserBeamState represents a phaser beam fired recently enough for clients to draw it
+type PhaserBeamState struct {
+	OwnerID entity.ID
+	TeamID  int
+	Start   physics.Vector2D
+	End     physics.Vector2D
+	HitID   entity.ID // Ship or projectile the beam struck, 0 if it hit nothing
+	Tick    uint64    // Tick the beam was fired on
+}
+
+// phaserHit is the nearest thing a phaser ray strikes
+type phaserHit struct {
+	distance   float64
+	ship       *entity.Ship
+	projectile *entity.Projectile
+}
+
+// fireHitscan resolves a hitscan shot from a ship along a ray at the given angle.
+// The beam stops at the first enemy ship or plasma torpedo it meets; ships take
+// damage falling off with distance, and torpedoes are shot down.
+// Note: Called from within locked context
+func (g *Game) fireHitscan(ship *entity.Ship, weapon entity.HitscanWeapon, angle float64) {
+	direction := physics.FromAngle(angle, 1)
+	hit := g.castPhaserRay(ship, direction, weapon.GetRange())
+
+	beam := PhaserBeamState{
+		OwnerID: ship.ID,
+		TeamID:  ship.TeamID,
+		Start:   ship.Position,
+		End:     ship.Position.Add(direction.Scale(hit.distance)),
+		Tick:    g.CurrentTick,
+	}
+
+	switch {
+	case hit.ship != nil:
+		beam.HitID = hit.ship.ID
+		g.applyWeaponDamage(hit.ship, weapon.DamageAt(hit.distance), ship.ID, ship.ID, ship.TeamID)
+	case hit.projectile != nil:
+		beam.HitID = hit.projectile.ID
+		hit.projectile.Active = false
+		g.EventBus.Publish(event.NewCollisionEvent(
+			g,
+			uint64(ship.ID),
+			uint64(hit.projectile.ID),
+		))
+	}
+
+	g.phaserBeams = append(g.phaserBeams, beam)
+}
+
+// castPhaserRay finds the nearest ship or interceptable projectile along a ray from the
+// shooter, out to maxRange. The returned distance is maxRange when nothing is hit.
+func (g *Game) castPhaserRay(shooter *entity.Ship, direction physics.Vector2D, maxRange float64) phaserHit {
+	hit := phaserHit{distance: maxRange}
+
+	for _, ship := range g.shipsInOrder() {
+		if !ship.Active || !g.canAttackShip(ship, shooter.ID, shooter.TeamID) {
+			continue
+		}
+		if d, ok := physics.RayCircleIntersection(shooter.Position, direction, ship.Collider); ok && d < hit.distance {
+			hit = phaserHit{distance: d, ship: ship}
+		}
+	}
+
+	for _, proj := range g.projectilesInOrder() {
+		if !g.canPhaserIntercept(shooter.TeamID, proj) {
+			continue
+		}
+		if d, ok := physics.RayCircleIntersection(shooter.Position, direction, proj.Collider); ok && d < hit.distance {
+			hit = phaserHit{distance: d, projectile: proj}
+		}
+	}
+
+	return hit
+}
+
+// canPhaserIntercept reports whether a phaser fired by the given team can shoot down a projectile.
+// Only enemy plasma torpedoes can be intercepted.
+func (g *Game) canPhaserIntercept(teamID int, proj *entity.Projectile) bool {
+	return proj.Active && proj.Type == "Plasma" && proj.TeamID != teamID
+}
+
+// expirePhaserBeams drops phaser beams that have been shown for phaserBeamDuration.
+// Note: Called from within locked context in Update()
+func (g *Game) expirePhaserBeams() {
+	lifetime := g.secondsToTicks(phaserBeamDuration)
+	kept := g.phaserBeams[:0]
+	for _, beam := range g.phaserBeams {
+		if g.CurrentTick-beam.Tick < lifetime {
+			kept = append(kept, beam)
+		}
+	}
+	g.phaserBeams = kept
+}
+
+// getPhaserBeams creates a snapshot of the phaser beams still being drawn.
+func (g *Game) getPhaserBeams() []PhaserBeamState {
+	if len(g.phaserBeams) == 0 {
+		return nil
+	}
+	return append([]PhaserBeamState(nil), g.phaserBeams...)
+}
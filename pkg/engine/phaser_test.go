@@ -0,0 +1,109 @@
+// Package engine provides unit tests for phaser.go
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+func TestGame_FireWeapon_PhaserHitscan(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 0, 1)
+	lineUpShips(ships, physics.Vector2D{X: -2000, Y: 2000}, 200)
+	shooter, teammate, enemy := ships[0], ships[1], ships[2]
+	shooter.Rotation = 0
+	teamHull, enemyHull := teammate.Hull, enemy.Hull
+
+	if err := game.FireWeapon(shooter.ID, 1); err != nil {
+		t.Fatalf("FireWeapon failed: %v", err)
+	}
+
+	if teammate.Hull != teamHull {
+		t.Error("phaser should pass through a teammate with friendly fire off")
+	}
+	phaser := shooter.Weapons[1].(*entity.Phaser)
+	distance := enemy.Position.Distance(shooter.Position) - enemy.Collider.Radius
+	if want := phaser.DamageAt(distance); enemyHull-enemy.Hull != want {
+		t.Errorf("enemy took %d damage, want %d", enemyHull-enemy.Hull, want)
+	}
+	if len(game.Projectiles) != 0 {
+		t.Error("phasers should not create projectiles")
+	}
+
+	beams := game.GetGameState().PhaserBeams
+	if len(beams) != 1 {
+		t.Fatalf("expected 1 phaser beam in state, got %d", len(beams))
+	}
+	if beams[0].HitID != enemy.ID || math.Abs(beams[0].End.Distance(shooter.Position)-distance) > 1e-9 {
+		t.Errorf("beam should end on the enemy's hull, got %+v", beams[0])
+	}
+}
+
+func TestGame_FireWeapon_PhaserRangeAndExpiry(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	shooter, enemy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: -2000, Y: 2000}, 2000)
+	shooter.Rotation = 0
+	hull := enemy.Hull
+
+	if err := game.FireWeapon(shooter.ID, 1); err != nil {
+		t.Fatalf("FireWeapon failed: %v", err)
+	}
+	if enemy.Hull != hull {
+		t.Error("phaser should not reach beyond its range")
+	}
+
+	beams := game.GetGameState().PhaserBeams
+	phaser := shooter.Weapons[1].(*entity.Phaser)
+	if len(beams) != 1 || beams[0].HitID != 0 || math.Abs(beams[0].End.X-shooter.Position.X-phaser.Range) > 1e-9 {
+		t.Fatalf("expected a missed beam ending at full range, got %+v", beams)
+	}
+
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+	if beams := game.GetGameState().PhaserBeams; len(beams) != 0 {
+		t.Errorf("phaser beam should expire from the state, got %d", len(beams))
+	}
+}
+
+func TestGame_FireWeaponAt_AimsAtTarget(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	shooter, enemy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: -2000, Y: 2000}, 200)
+	moveShip(enemy, physics.Vector2D{X: shooter.Position.X, Y: shooter.Position.Y + 300})
+	hull := enemy.Hull
+
+	if err := game.FireWeaponAt(shooter.ID, 1, enemy.ID); err != nil {
+		t.Fatalf("FireWeaponAt failed: %v", err)
+	}
+	if enemy.Hull >= hull {
+		t.Error("aimed phaser should hit a target off the ship's heading")
+	}
+	if err := game.FireWeaponAt(shooter.ID, 1, 9999); err == nil {
+		t.Error("expected error aiming at a missing ship")
+	}
+}
+
+func TestGame_FireWeaponAt_CloakedTarget(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	shooter, enemy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: -2000, Y: 2000}, 200)
+	enemy.Cloaked = true
+	moveShip(enemy, physics.Vector2D{X: shooter.Position.X, Y: shooter.Position.Y + CloakDetectionRadius + 100})
+
+	if err := game.FireWeaponAt(shooter.ID, 0, enemy.ID); err == nil {
+		t.Error("expected error aiming at a cloaked enemy outside detection range")
+	}
+
+	moveShip(enemy, physics.Vector2D{X: shooter.Position.X, Y: shooter.Position.Y + 300})
+	if err := game.FireWeaponAt(shooter.ID, 0, enemy.ID); err != nil {
+		t.Errorf("a detected cloaked enemy should be a valid target: %v", err)
+	}
+}
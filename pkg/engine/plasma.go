@@ -0,0 +1,62 @@
+// pkg/engine/plasma.go
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+)
+
+// homingSeekRange is how far ahead a homing projectile looks for a target
+const homingSeekRange = 1000.0
+
+// steerHomingProjectile turns a homing projectile towards the nearest enemy in its seek cone.
+// Note: Called from within locked context in Update()
+func (g *Game) steerHomingProjectile(proj *entity.Projectile, deltaTime float64) {
+	if !proj.IsHoming() {
+		return
+	}
+	if target, ok := g.findHomingTarget(proj); ok {
+		proj.SteerTowards(target.Position, deltaTime)
+	}
+}
+
+// findHomingTarget returns the nearest enemy ship within homingSeekRange and the
+// projectile's seek cone. Cloaked ships cannot be tracked.
+func (g *Game) findHomingTarget(proj *entity.Projectile) (*entity.Ship, bool) {
+	var nearest *entity.Ship
+	nearestDistance := homingSeekRange
+	for _, ship := range g.shipsInOrder() {
+		if !ship.Active || ship.Cloaked || ship.TeamID == proj.TeamID {
+			continue
+		}
+		distance := ship.Position.Distance(proj.Position)
+		if distance <= nearestDistance && proj.InSeekCone(ship.Position) {
+			nearest, nearestDistance = ship, distance
+		}
+	}
+	return nearest, nearest != nil
+}
+
+// explodeProjectile deactivates a projectile and deals its area damage over its BlastRadius.
+func (g *Game) explodeProjectile(proj *entity.Projectile) {
+	g.explodeProjectileWithRadius(proj, proj.BlastRadius)
+}
+
+// explodeProjectileWithRadius deactivates a projectile and deals its damage to every ship
+// caught in the blast, falling off linearly from full damage at the centre to none at
+// the edge of the radius. Friendly fire rules apply as for direct hits.
+func (g *Game) explodeProjectileWithRadius(proj *entity.Projectile, radius float64) {
+	proj.Active = false
+	for _, ship := range g.shipsInOrder() {
+		if !ship.Active || !g.canAttackShip(ship, proj.OwnerID, proj.TeamID) {
+			continue
+		}
+		distance := math.Max(0, ship.Position.Distance(proj.Position)-ship.Collider.Radius)
+		if distance >= radius {
+			continue
+		}
+		damage := int(math.Round(float64(proj.Damage) * (1 - distance/radius)))
+		g.applyProjectileDamage(ship, proj, damage)
+	}
+}
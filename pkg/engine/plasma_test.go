@@ -0,0 +1,115 @@
+// Package engine provides unit tests for plasma.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// plasmaFrom fires a plasma torpedo from the player's ship at a position and heading.
+func plasmaFrom(game *Game, player *Player, position physics.Vector2D, angle float64) *entity.Projectile {
+	proj := entity.NewPlasma(player.ShipID).CreateProjectile(player.ShipID, position, angle, player.TeamID)
+	game.Projectiles[proj.ID] = proj
+	return proj
+}
+
+func TestGame_Plasma_HomesOnEnemyInCone(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1, 1)
+	lineUpShips(ships, physics.Vector2D{X: -3000, Y: 2000}, 3000)
+	shooter, enemy := shipPlayer(game, ships[0]), ships[1]
+	enemy.Position = physics.Vector2D{X: -2400, Y: 2300}
+
+	ahead := plasmaFrom(game, shooter, physics.Vector2D{X: -3000, Y: 2000}, 0)
+	away := plasmaFrom(game, shooter, physics.Vector2D{X: -3000, Y: 2000}, 3)
+	awayRotation := away.Rotation
+
+	game.Update()
+
+	if ahead.Velocity.Y <= 0 {
+		t.Errorf("plasma should turn towards the enemy ahead of it, velocity %v", ahead.Velocity)
+	}
+	if away.Rotation != awayRotation {
+		t.Error("plasma should not track an enemy outside its seek cone")
+	}
+
+	enemy.Cloaked = true
+	rotation := ahead.Rotation
+	game.Update()
+	if ahead.Rotation != rotation {
+		t.Error("plasma should not track a cloaked ship")
+	}
+}
+
+func TestGame_Plasma_ExplodesWithAreaDamage(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1, 1)
+	lineUpShips(ships, physics.Vector2D{X: -3000, Y: 2000}, 3000)
+	shooter, near, far := shipPlayer(game, ships[0]), ships[1], ships[2]
+	far.Position = physics.Vector2D{X: near.Position.X + 70, Y: near.Position.Y}
+	nearHull, farHull := near.Hull, far.Hull
+
+	proj := plasmaFrom(game, shooter, near.Position, 0)
+	game.processShipDamage(near, proj)
+
+	if proj.Active {
+		t.Error("plasma should be spent after exploding")
+	}
+	nearDamage, farDamage := nearHull-near.Hull, farHull-far.Hull
+	if nearDamage != proj.Damage {
+		t.Errorf("ship at the centre took %d damage, want %d", nearDamage, proj.Damage)
+	}
+	if farDamage <= 0 || farDamage >= nearDamage {
+		t.Errorf("ship near the edge took %d damage, want between 0 and %d", farDamage, nearDamage)
+	}
+	if game.Ships[shooter.ShipID].Hull != game.Ships[shooter.ShipID].Stats.MaxHull {
+		t.Error("plasma should not damage its own ship")
+	}
+}
+
+func TestGame_Plasma_ExplodesAtEndOfRange(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1, 1)
+	lineUpShips(ships, physics.Vector2D{X: -3000, Y: 2000}, 3000)
+	shooter, enemy := shipPlayer(game, ships[0]), ships[1]
+	hull := enemy.Hull
+
+	proj := plasmaFrom(game, shooter, physics.Vector2D{X: enemy.Position.X - 60, Y: enemy.Position.Y + 60}, 3)
+	proj.DistanceTraveled = proj.Range
+
+	game.Update()
+
+	if proj.Active {
+		t.Error("plasma should be spent at the end of its range")
+	}
+	if enemy.Hull >= hull {
+		t.Error("plasma should explode at the end of its range and damage nearby ships")
+	}
+}
+
+func TestGame_Plasma_ShotDownByPhaser(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1, 1)
+	lineUpShips(ships, physics.Vector2D{X: -3000, Y: 2000}, 3000)
+	shooter, enemy := shipPlayer(game, ships[0]), ships[1]
+	hull := enemy.Hull
+
+	// Plasma closing on the enemy, which fires its phaser straight at it
+	plasma := plasmaFrom(game, shooter, physics.Vector2D{X: enemy.Position.X + 300, Y: enemy.Position.Y}, 3.14)
+	enemy.Rotation = 0
+	if err := game.FireWeapon(enemy.ID, 1); err != nil {
+		t.Fatalf("FireWeapon failed: %v", err)
+	}
+
+	if plasma.Active {
+		t.Error("phaser should shoot down the plasma")
+	}
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+	if enemy.Hull != hull {
+		t.Error("an intercepted plasma should fizzle without exploding")
+	}
+}
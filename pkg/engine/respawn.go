@@ -0,0 +1,131 @@
+// pkg/engine/respawn.go
+package engine
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+// pendingRespawn tracks a dead player waiting for a new ship
+type pendingRespawn struct {
+	PlayerID    entity.ID
+	TeamID      int
+	RespawnTick uint64 // Earliest tick at which the player may respawn
+	Requested   bool   // Player has asked to respawn (respawn-on-request mode)
+
+	// lastCountdown is the seconds-remaining value last published, -1 if none
+	lastCountdown int
+}
+
+// queueRespawn puts the owner of a destroyed ship into the death queue.
+// Ships that no longer belong to a player are ignored.
+func (g *Game) queueRespawn(ship *entity.Ship) {
+	player, ok := g.findPlayerByShipID(ship.ID)
+	if !ok {
+		return
+	}
+
+	delay := g.secondsToTicks(float64(g.Config.GameRules.RespawnDelay))
+	g.respawnQueue[player.ID] = &pendingRespawn{
+		PlayerID:      player.ID,
+		TeamID:        player.TeamID,
+		RespawnTick:   g.CurrentTick + delay,
+		lastCountdown: -1,
+	}
+	g.publishRespawnCountdown(g.respawnQueue[player.ID])
+}
+
+// processRespawnQueue respawns every queued player whose delay has elapsed.
+// Note: Called from within locked context in Update()
+func (g *Game) processRespawnQueue() {
+	for _, pending := range g.respawnsInOrder() {
+		player, err := g.findPlayerByID(pending.PlayerID)
+		if err != nil {
+			delete(g.respawnQueue, pending.PlayerID)
+			continue
+		}
+
+		g.publishRespawnCountdown(pending)
+		if !g.isRespawnDue(pending) {
+			continue
+		}
+
+		g.respawnShipInternal(player)
+	}
+}
+
+// isRespawnDue reports whether a queued player should respawn this tick.
+func (g *Game) isRespawnDue(pending *pendingRespawn) bool {
+	if g.CurrentTick < pending.RespawnTick {
+		return false
+	}
+	return pending.Requested || !g.Config.GameRules.RespawnOnRequest
+}
+
+// respawnSecondsRemaining returns the whole seconds left before a queued player may respawn.
+func (g *Game) respawnSecondsRemaining(pending *pendingRespawn) int {
+	if g.CurrentTick >= pending.RespawnTick {
+		return 0
+	}
+	return int(math.Ceil(g.ticksToSeconds(pending.RespawnTick - g.CurrentTick)))
+}
+
+// publishRespawnCountdown publishes a countdown event whenever the whole seconds remaining changes.
+func (g *Game) publishRespawnCountdown(pending *pendingRespawn) {
+	remaining := g.respawnSecondsRemaining(pending)
+	if remaining == pending.lastCountdown {
+		return
+	}
+	pending.lastCountdown = remaining
+
+	g.EventBus.Publish(event.NewRespawnEvent(
+		g,
+		uint64(pending.PlayerID),
+		pending.TeamID,
+		remaining,
+	))
+}
+
+// respawnsInOrder returns the queued respawns sorted by player ID for deterministic processing.
+func (g *Game) respawnsInOrder() []*pendingRespawn {
+	queue := make([]*pendingRespawn, 0, len(g.respawnQueue))
+	for _, pending := range g.respawnQueue {
+		queue = append(queue, pending)
+	}
+	slices.SortFunc(queue, func(a, b *pendingRespawn) int { return cmp.Compare(a.PlayerID, b.PlayerID) })
+	return queue
+}
+
+// RequestRespawn asks for a dead player's ship to be respawned.
+// In respawn-on-request mode the ship is created once the respawn delay has
+// elapsed; otherwise the request changes nothing as respawns are automatic.
+func (g *Game) RequestRespawn(playerID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	pending, ok := g.respawnQueue[playerID]
+	if !ok {
+		return errors.New("player is not waiting to respawn")
+	}
+	pending.Requested = true
+
+	return nil
+}
+
+// getRespawnTimers returns the seconds each queued player must still wait, keyed by player ID.
+func (g *Game) getRespawnTimers() map[entity.ID]float64 {
+	timers := make(map[entity.ID]float64)
+	for id, pending := range g.respawnQueue {
+		if g.CurrentTick >= pending.RespawnTick {
+			timers[id] = 0
+			continue
+		}
+		timers[id] = g.ticksToSeconds(pending.RespawnTick - g.CurrentTick)
+	}
+	return timers
+}
@@ -0,0 +1,143 @@
+// Package engine provides unit tests for respawn.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+// newRespawnTestGame creates a game with one player per team so destroying a
+// ship does not end the match, and returns the team 0 player.
+func newRespawnTestGame(t *testing.T, delay int, onRequest bool) (*Game, *Player) {
+	cfg := defaultConfig()
+	cfg.GameRules.RespawnDelay = delay
+	cfg.GameRules.RespawnOnRequest = onRequest
+	game := NewGame(cfg)
+
+	playerID, err := game.AddPlayer("Alice", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	if _, err := game.AddPlayer("Bob", 1); err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	player, _ := game.findPlayerByID(playerID)
+	return game, player
+}
+
+// destroyPlayerShip kills the player's current ship with no one credited for the kill.
+func destroyPlayerShip(game *Game, player *Player) entity.ID {
+	shipID := player.ShipID
+	game.handleShipDestruction(game.Ships[shipID], 0, -1)
+	return shipID
+}
+
+func TestGame_Respawn_AfterConfiguredDelay(t *testing.T) {
+	game, player := newRespawnTestGame(t, 2, false)
+
+	var countdown []int
+	game.EventBus.Subscribe(event.RespawnCountdown, func(e event.Event) {
+		countdown = append(countdown, e.(*event.RespawnEvent).SecondsRemaining)
+	})
+	respawned := 0
+	game.EventBus.Subscribe(event.ShipRespawned, func(e event.Event) {
+		respawned++
+	})
+
+	oldShipID := destroyPlayerShip(game, player)
+
+	// 2 seconds at 60 ticks per second
+	for i := 0; i < 120; i++ {
+		game.Update()
+	}
+	if player.ShipID != oldShipID || respawned != 0 {
+		t.Fatal("ship respawned before the respawn delay elapsed")
+	}
+	if _, ok := game.GetGameState().RespawnTimers[player.ID]; !ok {
+		t.Error("expected dead player in RespawnTimers")
+	}
+
+	game.Update()
+	if player.ShipID == oldShipID {
+		t.Fatal("ship was not respawned after the respawn delay")
+	}
+	if !game.Ships[player.ShipID].Active {
+		t.Error("respawned ship should be active")
+	}
+	if _, ok := game.Ships[oldShipID]; ok {
+		t.Error("destroyed ship should be removed on respawn")
+	}
+	if respawned != 1 {
+		t.Errorf("expected 1 ShipRespawned event, got %d", respawned)
+	}
+	if len(countdown) != 3 || countdown[0] != 2 || countdown[1] != 1 || countdown[2] != 0 {
+		t.Errorf("expected countdown [2 1 0], got %v", countdown)
+	}
+	if _, ok := game.GetGameState().RespawnTimers[player.ID]; ok {
+		t.Error("respawned player should leave RespawnTimers")
+	}
+}
+
+func TestGame_Respawn_NearTeamHomeworld(t *testing.T) {
+	game, player := newRespawnTestGame(t, 0, false)
+	destroyPlayerShip(game, player)
+	game.Update()
+
+	var earth *entity.Planet
+	for _, planet := range game.Planets {
+		earth = planet
+	}
+	ship := game.Ships[player.ShipID]
+	maxDistance := earth.Collider.Radius + 200
+	if d := ship.Position.Distance(earth.Position); d > maxDistance {
+		t.Errorf("respawned %.0f units from homeworld, want at most %.0f", d, maxDistance)
+	}
+}
+
+func TestGame_Respawn_OnRequest(t *testing.T) {
+	game, player := newRespawnTestGame(t, 1, true)
+
+	if err := game.RequestRespawn(player.ID); err == nil {
+		t.Error("expected error requesting respawn while alive")
+	}
+
+	oldShipID := destroyPlayerShip(game, player)
+	if err := game.RequestRespawn(player.ID); err != nil {
+		t.Fatalf("RequestRespawn failed: %v", err)
+	}
+
+	// The request is honoured only once the delay has elapsed
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+	if player.ShipID != oldShipID {
+		t.Fatal("ship respawned before the respawn delay elapsed")
+	}
+	game.Update()
+	if player.ShipID == oldShipID {
+		t.Fatal("requested respawn did not happen after the delay")
+	}
+
+	// Without a request the player stays dead
+	oldShipID = destroyPlayerShip(game, player)
+	for i := 0; i < 120; i++ {
+		game.Update()
+	}
+	if player.ShipID != oldShipID {
+		t.Error("ship respawned without a request in respawn-on-request mode")
+	}
+}
+
+func TestGame_Respawn_QueueClearedOnLeave(t *testing.T) {
+	game, player := newRespawnTestGame(t, 5, false)
+	destroyPlayerShip(game, player)
+
+	if err := game.RemovePlayer(player.ID); err != nil {
+		t.Fatalf("RemovePlayer failed: %v", err)
+	}
+	if _, ok := game.respawnQueue[player.ID]; ok {
+		t.Error("removed player should leave the respawn queue")
+	}
+}
@@ -0,0 +1,200 @@
+// pkg/engine/rotation.go
+package engine
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchResults represents the outcome of a finished match, shown until the next one starts
+type MatchResults struct {
+	WinningTeam int            // -1 for a draw
+	NextMatchIn float64        // Seconds until the next match starts, 0 if none is scheduled
+	NextGalaxy  string         `json:",omitempty"` // Galaxy template the next match is played on
+	Players     []PlayerResult // Sorted by score, highest first
+}
+
+// PlayerResult represents a player's stats for a finished match
+type PlayerResult struct {
+	ID       entity.ID
+	Name     string
+	TeamID   int
+	Score    int
+	Kills    int
+	Deaths   int
+	Captures int
+}
+
+// checkIntermission starts the next match once the results of the finished one have
+// been shown for GameRules.IntermissionTime seconds.
+// Note: Called from within locked context in Update()
+func (g *Game) checkIntermission() {
+	if g.Status != GameStatusEnded || g.Config.GameRules.IntermissionTime <= 0 {
+		return
+	}
+	if g.CurrentTick-g.endTick >= g.intermissionTicks() {
+		g.startNextMatch()
+	}
+}
+
+// intermissionTicks returns the length of the intermission between matches in ticks.
+func (g *Game) intermissionTicks() uint64 {
+	return g.secondsToTicks(float64(g.Config.GameRules.IntermissionTime))
+}
+
+// startNextMatch resets the galaxy for a new match, switching to the next galaxy in
+// the rotation if there is one. Planets, teams and ships are rebuilt from the config
+// and every player's stats are cleared. Players stay connected, keeping their team
+// if it still exists and otherwise moving to the smallest team, and get a fresh ship.
+// Tournament games return to the lobby; others start the match straight away.
+// Note: Called from within locked context in Update()
+func (g *Game) startNextMatch() {
+	caller := getCallerInfo()
+	galaxy := g.rotateGalaxy()
+
+	players := g.playersInOrder()
+	g.Teams = make(map[int]*Team)
+	g.Planets = make(map[entity.ID]*entity.Planet)
+	g.Ships = make(map[entity.ID]*entity.Ship)
+	g.Projectiles = make(map[entity.ID]*entity.Projectile)
+	g.respawnQueue = make(map[entity.ID]*pendingRespawn)
+	g.phaserBeams = nil
+	g.explosions = nil
+	g.initSpatialIndex()
+	g.initTeams()
+	g.initPlanets()
+	g.initFlags()
+
+	for _, player := range players {
+		g.rejoinPlayer(player)
+	}
+
+	g.WinningTeam = -1
+	g.teamsUnbalanced = false
+	g.Running = true
+
+	g.logger.WithField("caller", caller).WithFields(logrus.Fields{
+		"function": "startNextMatch",
+		"galaxy":   galaxy,
+		"players":  len(players),
+	}).Info("Galaxy reset for the next match")
+
+	g.EventBus.Publish(&event.BaseEvent{
+		EventType: event.MatchReset,
+		Source:    g,
+	})
+
+	if g.Config.GameRules.Tournament {
+		g.Status = GameStatusWaiting
+		return
+	}
+	g.beginMatch()
+}
+
+// rotateGalaxy applies the next galaxy template in GameRules.GalaxyRotation to the
+// config and returns its name, or returns "" when there is no rotation. A template
+// that cannot be found is skipped and the current galaxy is kept.
+func (g *Game) rotateGalaxy() string {
+	rotation := g.Config.GameRules.GalaxyRotation
+	if len(rotation) == 0 {
+		return ""
+	}
+
+	name := rotation[g.rotationIndex%len(rotation)]
+	g.rotationIndex++
+	if err := config.ApplyGalaxyTemplate(g.Config, name); err != nil {
+		g.logger.WithField("caller", getCallerInfo()).WithFields(logrus.Fields{
+			"function": "rotateGalaxy",
+			"galaxy":   name,
+			"error":    err.Error(),
+		}).Warn("Skipping unknown galaxy in rotation")
+		return ""
+	}
+	return name
+}
+
+// nextGalaxy returns the galaxy template the next match will be played on, or "" if
+// there is no rotation.
+func (g *Game) nextGalaxy() string {
+	rotation := g.Config.GameRules.GalaxyRotation
+	if len(rotation) == 0 {
+		return ""
+	}
+	return rotation[g.rotationIndex%len(rotation)]
+}
+
+// rejoinPlayer puts a player back on their team for a new match with cleared stats and
+// a fresh ship. Players whose team no longer exists join the smallest team.
+func (g *Game) rejoinPlayer(player *Player) {
+	team, ok := g.Teams[player.TeamID]
+	if !ok {
+		team = g.smallestTeam()
+	}
+
+	player.TeamID = team.ID
+	player.Score, player.Kills, player.Deaths = 0, 0, 0
+	player.Bombs, player.Captures, player.KillStreak = 0, 0, 0
+	player.Ready = false
+	player.ShipClass = g.startingShipClass(team.ID)
+	team.Players[player.ID] = player
+
+	ship := g.createAndAddShip(player)
+	g.assignShipToPlayer(player, ship, team)
+}
+
+// smallestTeam returns the team with the fewest players, regardless of capacity.
+func (g *Game) smallestTeam() *Team {
+	var smallest *Team
+	for _, team := range g.teamsInOrder() {
+		if smallest == nil || len(team.Players) < len(smallest.Players) {
+			smallest = team
+		}
+	}
+	return smallest
+}
+
+// playersInOrder returns every player on every team sorted by ID.
+func (g *Game) playersInOrder() []*Player {
+	var players []*Player
+	for _, team := range g.Teams {
+		for _, player := range team.Players {
+			players = append(players, player)
+		}
+	}
+	slices.SortFunc(players, func(a, b *Player) int { return cmp.Compare(a.ID, b.ID) })
+	return players
+}
+
+// getMatchResults creates a snapshot of the finished match's results, or nil while a
+// match is waiting or in progress.
+func (g *Game) getMatchResults() *MatchResults {
+	if g.Status != GameStatusEnded {
+		return nil
+	}
+
+	results := &MatchResults{WinningTeam: g.WinningTeam}
+	if g.Config.GameRules.IntermissionTime > 0 {
+		remaining := g.intermissionTicks() - min(g.CurrentTick-g.endTick, g.intermissionTicks())
+		results.NextMatchIn = g.ticksToSeconds(remaining)
+		results.NextGalaxy = g.nextGalaxy()
+	}
+
+	for _, player := range g.playersInOrder() {
+		results.Players = append(results.Players, PlayerResult{
+			ID:       player.ID,
+			Name:     player.Name,
+			TeamID:   player.TeamID,
+			Score:    player.Score,
+			Kills:    player.Kills,
+			Deaths:   player.Deaths,
+			Captures: player.Captures,
+		})
+	}
+	slices.SortStableFunc(results.Players, func(a, b PlayerResult) int { return cmp.Compare(b.Score, a.Score) })
+	return results
+}
@@ -0,0 +1,126 @@
+// Package engine provides unit tests for rotation.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+)
+
+// newRotationTestGame starts a game with a five second intermission and one player on
+// each of the given teams.
+func newRotationTestGame(t *testing.T, cfg *config.GameConfig, teams ...int) (*Game, []entity.ID) {
+	cfg.GameRules.IntermissionTime = 5
+	game := NewGame(cfg)
+	game.Start()
+
+	var players []entity.ID
+	for _, teamID := range teams {
+		id, err := game.AddPlayer("pilot", teamID)
+		if err != nil {
+			t.Fatalf("AddPlayer failed: %v", err)
+		}
+		players = append(players, id)
+	}
+	return game, players
+}
+
+// finishMatch ends the current match and runs the game through the intermission.
+func finishMatch(game *Game) {
+	game.EntityLock.Lock()
+	game.endGameInternal()
+	game.EntityLock.Unlock()
+	for i := uint64(0); i < game.intermissionTicks(); i++ {
+		game.Update()
+	}
+}
+
+func TestGame_Intermission_ShowsResultsThenResets(t *testing.T) {
+	game, players := newRotationTestGame(t, defaultConfig(), 0, 1)
+	player, _ := game.findPlayerByID(players[0])
+	player.Score, player.Kills = 30, 3
+	oldShipID := player.ShipID
+	earth := planetNamed(game, "Earth")
+	earth.TeamID = 1
+
+	game.EntityLock.Lock()
+	game.endGameInternal()
+	game.EntityLock.Unlock()
+
+	results := game.GetGameState().Results
+	if results == nil || results.NextMatchIn != 5 {
+		t.Fatalf("expected results with the next match in 5s, got %+v", results)
+	}
+	if len(results.Players) != 2 || results.Players[0].ID != player.ID || results.Players[0].Kills != 3 {
+		t.Errorf("expected the top scorer first in the results, got %+v", results.Players)
+	}
+
+	for i := uint64(0); i < game.intermissionTicks(); i++ {
+		game.Update()
+	}
+
+	if game.Status != GameStatusActive || game.GetGameState().Results != nil {
+		t.Fatalf("the next match should start after the intermission, status %v", game.Status)
+	}
+	player, err := game.findPlayerByID(players[0])
+	if err != nil {
+		t.Fatal("players should stay in the game across the reset")
+	}
+	if player.Score != 0 || player.Kills != 0 || player.TeamID != 0 {
+		t.Errorf("player stats should be cleared and the team kept: %+v", player)
+	}
+	ship, ok := game.Ships[player.ShipID]
+	if !ok || !ship.Active || player.ShipID == oldShipID {
+		t.Error("players should get a fresh ship for the next match")
+	}
+	if earth := planetNamed(game, "Earth"); earth.TeamID != 0 || game.Teams[0].PlanetCount != 1 {
+		t.Error("planets should be reset to the configured galaxy")
+	}
+}
+
+func TestGame_Intermission_RotatesGalaxies(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Teams = append(cfg.Teams, config.TeamConfig{Name: "Green", Color: "#0f0"})
+	cfg.GameRules.GalaxyRotation = []string{"small_galaxy", "balanced_4team"}
+	game, players := newRotationTestGame(t, cfg, 0, 1, 2)
+
+	game.EntityLock.Lock()
+	game.endGameInternal()
+	game.EntityLock.Unlock()
+	if next := game.GetGameState().Results.NextGalaxy; next != "small_galaxy" {
+		t.Errorf("results should name the next galaxy, got %q", next)
+	}
+	for i := uint64(0); i < game.intermissionTicks(); i++ {
+		game.Update()
+	}
+
+	if game.Config.WorldSize != 6000 || len(game.Planets) != 4 || len(game.Teams) != 2 {
+		t.Fatalf("expected the small galaxy, got world %v with %d planets and %d teams",
+			game.Config.WorldSize, len(game.Planets), len(game.Teams))
+	}
+	moved, _ := game.findPlayerByID(players[2])
+	if moved == nil || moved.TeamID != 0 {
+		t.Errorf("a player whose team was rotated out should join the smallest team, got %+v", moved)
+	}
+
+	finishMatch(game)
+	if game.Config.WorldSize != 12000 || len(game.Teams) != 4 {
+		t.Errorf("expected the balanced galaxy next, got world %v", game.Config.WorldSize)
+	}
+}
+
+func TestGame_Intermission_DisabledKeepsFinishedGame(t *testing.T) {
+	cfg := defaultConfig()
+	game, _ := newRotationTestGame(t, cfg, 0, 1)
+	game.Config.GameRules.IntermissionTime = 0
+
+	finishMatch(game)
+	for i := 0; i < 600; i++ {
+		game.Update()
+	}
+
+	if game.Status != GameStatusEnded {
+		t.Errorf("without an intermission the game should stay ended, status %v", game.Status)
+	}
+}
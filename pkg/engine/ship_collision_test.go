@@ -0,0 +1,111 @@
+// Package engine provides unit tests for ship-ship collisions
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/config"
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// collisionConfig returns a wide world config with collision damage turned on.
+func collisionConfig() *config.GameConfig {
+	cfg := wideConfig()
+	cfg.PhysicsConfig.CollisionDamage = 10
+	return cfg
+}
+
+// flyHeadOn places two ships just overlapping on the X axis with their shields down,
+// flying head-on at the given speed.
+func flyHeadOn(a, b *entity.Ship, speed float64) {
+	moveShip(a, physics.Vector2D{X: -19, Y: 300})
+	moveShip(b, physics.Vector2D{X: 19, Y: 300})
+	a.Velocity = physics.Vector2D{X: speed}
+	b.Velocity = physics.Vector2D{X: -speed}
+	a.Shields, b.Shields = 0, 0
+}
+
+func TestGame_ShipShipCollision_ElasticResponse(t *testing.T) {
+	game := NewGame(collisionConfig())
+	ships := addShips(t, game, 0, 1)
+	a, b := ships[0], ships[1]
+	flyHeadOn(a, b, 100)
+	hullA, hullB := a.Hull, b.Hull
+
+	collisions := 0
+	game.EventBus.Subscribe(event.EntityCollision, func(e event.Event) {
+		collisions++
+	})
+
+	game.handleShipShipCollision(a, b)
+
+	// Equal masses exchange velocities in a head-on elastic collision
+	if math.Abs(a.Velocity.X+100) > 1e-9 || math.Abs(b.Velocity.X-100) > 1e-9 {
+		t.Errorf("velocities after collision = %v, %v; want swapped", a.Velocity, b.Velocity)
+	}
+	if d := a.Position.Distance(b.Position); d < a.Collider.Radius+b.Collider.Radius-1e-9 {
+		t.Errorf("ships still overlap after collision, distance %.2f", d)
+	}
+	// Closing speed 200 at CollisionDamage 10 per 100 units/s
+	if hullA-a.Hull != 20 || hullB-b.Hull != 20 {
+		t.Errorf("collision damage = %d, %d; want 20 each", hullA-a.Hull, hullB-b.Hull)
+	}
+	if collisions != 1 {
+		t.Errorf("expected 1 EntityCollision event, got %d", collisions)
+	}
+}
+
+func TestGame_ShipShipCollision_SeparatingShipsKeepVelocity(t *testing.T) {
+	game := NewGame(collisionConfig())
+	ships := addShips(t, game, 0, 1)
+	a, b := ships[0], ships[1]
+	flyHeadOn(a, b, -50)
+	hullA := a.Hull
+
+	game.handleShipShipCollision(a, b)
+
+	if a.Velocity.X != -50 || b.Velocity.X != 50 {
+		t.Errorf("separating ships should keep their velocity, got %v, %v", a.Velocity, b.Velocity)
+	}
+	if a.Hull != hullA {
+		t.Error("separating ships should not take damage")
+	}
+}
+
+func TestGame_ShipShipCollision_RammingKillCredit(t *testing.T) {
+	game := NewGame(collisionConfig())
+	ships := addShips(t, game, 0, 1)
+	flyHeadOn(ships[0], ships[1], 100)
+	rammer, victim := shipPlayer(game, ships[0]), shipPlayer(game, ships[1])
+	ships[1].Hull = 1
+
+	game.handleShipShipCollision(ships[0], ships[1])
+
+	if ships[1].Active {
+		t.Fatal("rammed ship should be destroyed")
+	}
+	if rammer.Kills != 1 || victim.Deaths != 1 {
+		t.Errorf("rammer kills=%d victim deaths=%d, want 1 and 1", rammer.Kills, victim.Deaths)
+	}
+}
+
+func TestGame_ShipShipCollision_TeammatesBounceWithoutDamage(t *testing.T) {
+	game := NewGame(collisionConfig())
+	ships := addShips(t, game, 0, 1)
+	a, b := ships[0], ships[1]
+	flyHeadOn(a, b, 100)
+	b.TeamID = a.TeamID
+	hullA, hullB := a.Hull, b.Hull
+
+	game.handleShipShipCollision(a, b)
+
+	if a.Velocity.X >= 0 {
+		t.Error("teammates should still bounce off each other")
+	}
+	if a.Hull != hullA || b.Hull != hullB {
+		t.Error("teammates should not damage each other with friendly fire off")
+	}
+}
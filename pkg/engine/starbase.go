@@ -0,0 +1,163 @@
+// pkg/engine/starbase.go
+package engine
+
+import (
+	"errors"
+	"math"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+const (
+	// defaultStarbaseMinKills is the session kills needed to fly a starbase when the config sets none
+	defaultStarbaseMinKills = 10
+	// dockRange is how far apart, hull to hull, a ship and a starbase can be to dock
+	dockRange = 100.0
+	// dockMaxSpeed is the fastest a ship can be moving relative to a starbase to dock, in units/s
+	dockMaxSpeed = 60.0
+	// dockGap is the space kept between a docked ship's hull and the starbase's
+	dockGap = 5.0
+	// dockingPorts is how many ships a starbase can hold docked at once
+	dockingPorts = 4
+	// dockRefuelRate is the fuel gained per second while docked
+	dockRefuelRate = 100.0
+)
+
+// Dock docks a ship with the nearest friendly starbase in range. The ship must be
+// nearly matching the starbase's velocity, and the starbase needs a free docking
+// port. Docked ships ride along with the starbase, are refuelled and repaired while
+// docked and are re-armed on docking; thrusting or warping undocks.
+func (g *Game) Dock(shipID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	if ship.DockedTo != 0 {
+		return nil // Already docked
+	}
+	if ship.Class == entity.Starbase {
+		return errors.New("a starbase cannot dock")
+	}
+
+	base, ok := g.findDockableStarbase(ship)
+	if !ok {
+		return errors.New("no friendly starbase in docking range")
+	}
+	if ship.Velocity.Sub(base.Velocity).Length() > dockMaxSpeed {
+		return errors.New("ship is moving too fast to dock")
+	}
+	if g.dockedShipCount(base) >= dockingPorts {
+		return errors.New("starbase has no free docking ports")
+	}
+
+	g.leaveOrbit(ship)
+	ship.DockedTo = base.ID
+	ship.Rearm()
+	g.holdDock(ship, base)
+	return nil
+}
+
+// Undock releases a ship from the starbase it is docked with.
+func (g *Game) Undock(shipID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	g.undock(ship)
+	return nil
+}
+
+// findDockableStarbase returns the nearest active friendly starbase whose hull is
+// within dockRange of the ship's.
+func (g *Game) findDockableStarbase(ship *entity.Ship) (*entity.Ship, bool) {
+	var nearest *entity.Ship
+	nearestGap := math.Inf(1)
+	for _, base := range g.shipsInOrder() {
+		if !base.Active || base.Class != entity.Starbase || base.TeamID != ship.TeamID || base.ID == ship.ID {
+			continue
+		}
+		gap := ship.Position.Distance(base.Position) - ship.Collider.Radius - base.Collider.Radius
+		if gap <= dockRange && gap < nearestGap {
+			nearest, nearestGap = base, gap
+		}
+	}
+	return nearest, nearest != nil
+}
+
+// dockedShipCount returns how many ships are docked with a starbase.
+func (g *Game) dockedShipCount(base *entity.Ship) int {
+	count := 0
+	for _, ship := range g.Ships {
+		if ship.Active && ship.DockedTo == base.ID {
+			count++
+		}
+	}
+	return count
+}
+
+// breakDockIfManeuvering undocks a ship when it thrusts or warps.
+// Note: Called from within locked context in Update()
+func (g *Game) breakDockIfManeuvering(ship *entity.Ship) {
+	if ship.DockedTo != 0 && (ship.Thrusting || ship.Warping) {
+		g.undock(ship)
+	}
+}
+
+// undock releases a ship from its starbase and stops any repairs.
+func (g *Game) undock(ship *entity.Ship) {
+	if ship.DockedTo == 0 {
+		return
+	}
+	ship.DockedTo = 0
+	ship.RepairMode = false
+}
+
+// updateDockedShips carries docked ships along with their starbases and services them.
+// It runs after every ship has moved so docked ships keep up with their base.
+// Note: Called from within locked context in Update()
+func (g *Game) updateDockedShips(deltaTime float64, ships []*entity.Ship) {
+	for _, ship := range ships {
+		if !ship.Active || ship.DockedTo == 0 {
+			continue
+		}
+		base, ok := g.Ships[ship.DockedTo]
+		if !ok || !base.Active {
+			g.undock(ship)
+			continue
+		}
+
+		g.holdDock(ship, base)
+		g.wrapEntityPosition(ship, ships)
+
+		ship.Refuel(dockRefuelRate, deltaTime)
+		ship.RepairMode = ship.Hull < ship.Stats.MaxHull
+		ship.RepairTick(deltaTime)
+	}
+}
+
+// holdDock snaps a docked ship against the starbase's hull at its current bearing
+// and matches the starbase's velocity.
+func (g *Game) holdDock(ship, base *entity.Ship) {
+	radius := base.Collider.Radius + ship.Collider.Radius + dockGap
+	offset := ship.Position.Sub(base.Position)
+	if offset.Length() == 0 {
+		offset = physics.Vector2D{X: 1}
+	}
+
+	ship.Position = base.Position.Add(offset.Normalize().Scale(radius))
+	ship.Collider.Center = ship.Position
+	ship.Velocity = base.Velocity
+}
@@ -0,0 +1,127 @@
+// Package engine provides unit tests for starbase.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// starbaseFrom replaces a ship with a starbase of the same ID and team at its position.
+func starbaseFrom(game *Game, ship *entity.Ship) *entity.Ship {
+	base := entity.NewShip(ship.ID, entity.Starbase, ship.TeamID, ship.Position)
+	game.Ships[base.ID] = base
+	return base
+}
+
+func TestGame_Dock_ServicesAndFollowsStarbase(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0, 0)
+	lineUpShips(ships, physics.Vector2D{X: 1920, Y: 2000}, 80)
+	base, escort := starbaseFrom(game, ships[1]), ships[2]
+	escort.Hull = escort.Stats.MaxHull / 2
+	escort.Fuel = 100
+	escort.Cooldowns["Torpedo"] = 5
+	hull, fuel := escort.Hull, escort.Fuel
+
+	if err := game.Dock(escort.ID); err != nil {
+		t.Fatalf("Dock failed: %v", err)
+	}
+	if len(escort.Cooldowns) != 0 {
+		t.Error("docking should re-arm the ship")
+	}
+
+	base.Velocity = physics.Vector2D{X: 0, Y: 30}
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+
+	if escort.DockedTo != base.ID {
+		t.Fatal("ship should stay docked")
+	}
+	if escort.Hull <= hull || escort.Fuel <= fuel {
+		t.Errorf("docked ship should be repaired and refuelled: hull %d fuel %d", escort.Hull, escort.Fuel)
+	}
+	gap := escort.Position.Distance(base.Position) - escort.Collider.Radius - base.Collider.Radius
+	if gap < dockGap-1 || gap > dockGap+1 {
+		t.Errorf("docked ship should ride against the starbase's hull, gap %.1f", gap)
+	}
+	if escort.Velocity != base.Velocity {
+		t.Errorf("docked ship velocity %v should match the starbase's %v", escort.Velocity, base.Velocity)
+	}
+
+	escort.Thrusting = true
+	game.Update()
+	if escort.DockedTo != 0 {
+		t.Error("thrusting should undock the ship")
+	}
+}
+
+func TestGame_Dock_Validation(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0, 0)
+	lineUpShips(ships, physics.Vector2D{X: 1920, Y: 2000}, 80)
+	enemy, base, escort := ships[0], starbaseFrom(game, ships[1]), ships[2]
+
+	if err := game.Dock(enemy.ID); err == nil {
+		t.Error("expected error docking with an enemy starbase")
+	}
+	if err := game.Dock(base.ID); err == nil {
+		t.Error("expected error docking a starbase")
+	}
+
+	escort.Velocity = physics.Vector2D{X: dockMaxSpeed * 2}
+	if err := game.Dock(escort.ID); err == nil {
+		t.Error("expected error docking too fast")
+	}
+
+	escort.Velocity = physics.Vector2D{}
+	escort.Position = physics.Vector2D{X: 2500, Y: 2000}
+	if err := game.Dock(escort.ID); err == nil {
+		t.Error("expected error docking out of range")
+	}
+}
+
+func TestGame_Dock_UndockedWhenStarbaseDestroyed(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 1, 0, 0)
+	lineUpShips(ships, physics.Vector2D{X: 1920, Y: 2000}, 80)
+	base, escort := starbaseFrom(game, ships[1]), ships[2]
+	if err := game.Dock(escort.ID); err != nil {
+		t.Fatalf("Dock failed: %v", err)
+	}
+
+	base.Active = false
+	game.updateDockedShips(1.0/60.0, game.shipsInOrder())
+
+	if escort.DockedTo != 0 {
+		t.Error("ship should be released when its starbase is gone")
+	}
+}
+
+func TestGame_RequestShipClass_Starbase(t *testing.T) {
+	game := NewGame(defaultConfig())
+	id1, _ := game.AddPlayer("One", 0)
+	id2, _ := game.AddPlayer("Two", 0)
+	game.AddPlayer("Three", 1)
+	for _, id := range []entity.ID{id1, id2} {
+		player, _ := game.findPlayerByID(id)
+		game.Ships[player.ShipID].Active = false
+	}
+
+	if err := game.RequestShipClass(id1, entity.Starbase); err == nil {
+		t.Error("expected rejection without enough kills")
+	}
+
+	player1, _ := game.findPlayerByID(id1)
+	player2, _ := game.findPlayerByID(id2)
+	player1.Kills = defaultStarbaseMinKills
+	player2.Kills = defaultStarbaseMinKills
+	if err := game.RequestShipClass(id1, entity.Starbase); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := game.RequestShipClass(id2, entity.Starbase); err == nil {
+		t.Error("expected rejection for a second starbase on the team")
+	}
+}
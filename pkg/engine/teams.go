@@ -0,0 +1,117 @@
+// pkg/engine/teams.go
+package engine
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+// AutoTeam is the team ID a player can join with to be placed on the smallest team
+const AutoTeam = -1
+
+// PlayerTeamID returns the ID of the team a player is on.
+func (g *Game) PlayerTeamID(playerID entity.ID) (int, error) {
+	g.EntityLock.RLock()
+	defer g.EntityLock.RUnlock()
+
+	_, team, err := g.findPlayerAndTeam(playerID)
+	if err != nil {
+		return 0, err
+	}
+	return team.ID, nil
+}
+
+// checkTeamCapacity returns an error if the game or the team has no room for another
+// player. A MaxPlayers or MaxShips of 0 means no limit.
+func (g *Game) checkTeamCapacity(team *Team) error {
+	if g.Config.MaxPlayers > 0 && g.playerCount() >= g.Config.MaxPlayers {
+		return errors.New("game is full")
+	}
+	if maxShips := g.teamMaxShips(team.ID); maxShips > 0 && len(team.Players) >= maxShips {
+		return errors.New("team is full")
+	}
+	return nil
+}
+
+// teamMaxShips returns the configured player limit for a team, 0 if it has none.
+func (g *Game) teamMaxShips(teamID int) int {
+	if teamID >= 0 && teamID < len(g.Config.Teams) {
+		return g.Config.Teams[teamID].MaxShips
+	}
+	return 0
+}
+
+// playerCount returns the number of players on all teams.
+func (g *Game) playerCount() int {
+	count := 0
+	for _, team := range g.Teams {
+		count += len(team.Players)
+	}
+	return count
+}
+
+// autoTeam picks the team for a player joining with AutoTeam: the one with the fewest
+// players, then the lowest score, that still has room.
+func (g *Game) autoTeam() (*Team, error) {
+	var best *Team
+	for _, team := range g.teamsInOrder() {
+		if g.checkTeamCapacity(team) != nil {
+			continue
+		}
+		if best == nil || len(team.Players) < len(best.Players) ||
+			(len(team.Players) == len(best.Players) && team.Score < best.Score) {
+			best = team
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no team has room for another player")
+	}
+	return best, nil
+}
+
+// teamsInOrder returns all teams sorted by ID.
+func (g *Game) teamsInOrder() []*Team {
+	teams := make([]*Team, 0, len(g.Teams))
+	for _, team := range g.Teams {
+		teams = append(teams, team)
+	}
+	slices.SortFunc(teams, func(a, b *Team) int { return cmp.Compare(a.ID, b.ID) })
+	return teams
+}
+
+// checkTeamBalance publishes a TeamsUnbalanced event when the largest and smallest teams
+// drift more than GameRules.TeamBalanceMargin players apart. It publishes once per
+// imbalance and again only after the teams have been brought back within the margin.
+// Note: Called from within locked context
+func (g *Game) checkTeamBalance() {
+	margin := g.Config.GameRules.TeamBalanceMargin
+	if margin <= 0 || len(g.Teams) < 2 {
+		return
+	}
+
+	var larger, smaller *Team
+	for _, team := range g.teamsInOrder() {
+		if larger == nil || len(team.Players) > len(larger.Players) {
+			larger = team
+		}
+		if smaller == nil || len(team.Players) < len(smaller.Players) {
+			smaller = team
+		}
+	}
+
+	unbalanced := len(larger.Players)-len(smaller.Players) > margin
+	if unbalanced && !g.teamsUnbalanced {
+		g.EventBus.Publish(event.NewTeamBalanceEvent(
+			g,
+			larger.ID,
+			len(larger.Players),
+			smaller.ID,
+			len(smaller.Players),
+		))
+	}
+	g.teamsUnbalanced = unbalanced
+}
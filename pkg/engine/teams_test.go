@@ -0,0 +1,91 @@
+// Package engine provides unit tests for teams.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+func TestGame_AddPlayer_TeamCapacity(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Teams[0].MaxShips = 1
+	cfg.MaxPlayers = 2
+	game := NewGame(cfg)
+
+	if _, err := game.AddPlayer("One", 0); err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	if _, err := game.AddPlayer("Two", 0); err == nil {
+		t.Error("expected error joining a full team")
+	}
+	if _, err := game.AddPlayer("Two", 1); err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	if _, err := game.AddPlayer("Three", 1); err == nil {
+		t.Error("expected error joining a full game")
+	}
+	if _, err := game.AddPlayer("Three", AutoTeam); err == nil {
+		t.Error("expected error auto-joining a full game")
+	}
+}
+
+func TestGame_AddPlayer_AutoTeam(t *testing.T) {
+	game := NewGame(defaultConfig())
+	game.AddPlayer("One", 0)
+
+	id, err := game.AddPlayer("Two", AutoTeam)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	if teamID, _ := game.PlayerTeamID(id); teamID != 1 {
+		t.Errorf("auto team should pick the smaller team, got %d", teamID)
+	}
+
+	game.Teams[0].Score = 50
+	id, _ = game.AddPlayer("Three", AutoTeam)
+	if teamID, _ := game.PlayerTeamID(id); teamID != 1 {
+		t.Errorf("auto team should break ties in favour of the weaker team, got %d", teamID)
+	}
+
+	game.Config.Teams[1].MaxShips = 2
+	id, _ = game.AddPlayer("Four", AutoTeam)
+	if teamID, _ := game.PlayerTeamID(id); teamID != 0 {
+		t.Errorf("auto team should skip a full team, got %d", teamID)
+	}
+}
+
+func TestGame_CheckTeamBalance_PromptsOnce(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.GameRules.TeamBalanceMargin = 1
+	game := NewGame(cfg)
+
+	var prompts []*event.TeamBalanceEvent
+	game.EventBus.Subscribe(event.TeamsUnbalanced, func(e event.Event) {
+		prompts = append(prompts, e.(*event.TeamBalanceEvent))
+	})
+
+	blue, _ := game.AddPlayer("Blue", 1)
+	game.AddPlayer("Red1", 0)
+	game.AddPlayer("Red2", 0)
+	if len(prompts) != 0 {
+		t.Fatal("teams within the margin should not be prompted")
+	}
+
+	game.RemovePlayer(blue)
+	game.AddPlayer("Red3", 0)
+	if len(prompts) != 1 {
+		t.Fatalf("expected one rebalance prompt, got %d", len(prompts))
+	}
+	if p := prompts[0]; p.LargerTeamID != 0 || p.LargerSize != 2 || p.SmallerTeamID != 1 || p.SmallerSize != 0 {
+		t.Errorf("unexpected rebalance prompt %+v", p)
+	}
+
+	game.AddPlayer("Blue", 1)
+	game.AddPlayer("Blue", 1)
+	game.AddPlayer("Red4", 0)
+	game.AddPlayer("Red5", 0)
+	if len(prompts) != 2 {
+		t.Errorf("a new imbalance after rebalancing should prompt again, got %d prompts", len(prompts))
+	}
+}
@@ -0,0 +1,106 @@
+// pkg/engine/tournament.go
+package engine
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+)
+
+// LobbyState represents the tournament lobby while the game waits for players to ready up
+type LobbyState struct {
+	MinReadyPerTeam int
+	ReadyCounts     map[int]int // Ready players per team ID
+	ReadyPlayers    []entity.ID // Players who have marked ready, sorted by ID
+}
+
+// SetReady marks a player as ready, or not, to start the tournament match. Once every
+// team has enough ready players the match begins.
+func (g *Game) SetReady(playerID entity.ID, ready bool) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if !g.inLobby() {
+		return errors.New("game is not waiting in the tournament lobby")
+	}
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	player, _, err := g.findPlayerAndTeam(playerID)
+	if err != nil {
+		return err
+	}
+
+	player.Ready = ready
+	if g.lobbyReady() {
+		g.beginMatch()
+	}
+	return nil
+}
+
+// inLobby reports whether a tournament game is waiting in the lobby for players to ready up.
+func (g *Game) inLobby() bool {
+	return g.Config.GameRules.Tournament && g.Status == GameStatusWaiting
+}
+
+// statsCount reports whether kills, deaths, bombing and captures are recorded. In
+// tournament mode they only count once the match has started.
+func (g *Game) statsCount() bool {
+	return !g.inLobby()
+}
+
+// minReadyPerTeam returns the ready players each team needs to start a tournament match.
+func (g *Game) minReadyPerTeam() int {
+	if required := g.Config.GameRules.MinReadyPerTeam; required > 0 {
+		return required
+	}
+	return 1
+}
+
+// readyCounts returns the number of ready players on each team.
+func (g *Game) readyCounts() map[int]int {
+	counts := make(map[int]int, len(g.Teams))
+	for id, team := range g.Teams {
+		counts[id] = 0
+		for _, player := range team.Players {
+			if player.Ready {
+				counts[id]++
+			}
+		}
+	}
+	return counts
+}
+
+// lobbyReady reports whether every team has enough ready players to start the match.
+func (g *Game) lobbyReady() bool {
+	for _, count := range g.readyCounts() {
+		if count < g.minReadyPerTeam() {
+			return false
+		}
+	}
+	return len(g.Teams) > 0
+}
+
+// getLobbyState creates a snapshot of the tournament lobby, or nil outside the lobby.
+func (g *Game) getLobbyState() *LobbyState {
+	if !g.inLobby() {
+		return nil
+	}
+
+	var ready []entity.ID
+	for _, team := range g.Teams {
+		for _, player := range team.Players {
+			if player.Ready {
+				ready = append(ready, player.ID)
+			}
+		}
+	}
+	slices.Sort(ready)
+
+	return &LobbyState{
+		MinReadyPerTeam: g.minReadyPerTeam(),
+		ReadyCounts:     g.readyCounts(),
+		ReadyPlayers:    ready,
+	}
+}
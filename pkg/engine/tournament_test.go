@@ -0,0 +1,123 @@
+// Package engine provides unit tests for tournament.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
+)
+
+// newTournamentTestGame creates a tournament game needing two ready players per team,
+// opened in the lobby with two players on each team.
+func newTournamentTestGame(t *testing.T) (*Game, []entity.ID) {
+	cfg := defaultConfig()
+	cfg.GameRules.Tournament = true
+	cfg.GameRules.MinReadyPerTeam = 2
+	game := NewGame(cfg)
+	game.Start()
+
+	var players []entity.ID
+	for _, teamID := range []int{0, 0, 1, 1} {
+		id, err := game.AddPlayer("pilot", teamID)
+		if err != nil {
+			t.Fatalf("AddPlayer failed: %v", err)
+		}
+		players = append(players, id)
+	}
+	return game, players
+}
+
+func TestGame_Tournament_StartsWhenTeamsReady(t *testing.T) {
+	game, players := newTournamentTestGame(t)
+	started := 0
+	game.EventBus.Subscribe(event.GameStarted, func(e event.Event) {
+		started++
+	})
+
+	if game.Status != GameStatusWaiting {
+		t.Fatalf("a tournament game should open in the lobby, status %v", game.Status)
+	}
+
+	for _, id := range players[:3] {
+		if err := game.SetReady(id, true); err != nil {
+			t.Fatalf("SetReady failed: %v", err)
+		}
+	}
+	lobby := game.GetGameState().Lobby
+	if lobby == nil || lobby.ReadyCounts[0] != 2 || lobby.ReadyCounts[1] != 1 || len(lobby.ReadyPlayers) != 3 {
+		t.Fatalf("unexpected lobby state %+v", lobby)
+	}
+	if game.Status != GameStatusWaiting || started != 0 {
+		t.Fatal("the match should wait until every team has enough ready players")
+	}
+
+	game.SetReady(players[3], true)
+	if game.Status != GameStatusActive || started != 1 {
+		t.Errorf("the match should start once every team is ready, status %v", game.Status)
+	}
+	if game.GetGameState().Lobby != nil {
+		t.Error("the lobby should be gone once the match starts")
+	}
+	if err := game.SetReady(players[0], false); err == nil {
+		t.Error("expected error changing readiness after the match has started")
+	}
+}
+
+func TestGame_Tournament_StatsOnlyCountInMatch(t *testing.T) {
+	game, players := newTournamentTestGame(t)
+	killer, _ := game.findPlayerByID(players[0])
+	victim, _ := game.findPlayerByID(players[2])
+
+	destroy := func() {
+		ship := game.Ships[victim.ShipID]
+		ship.Active, ship.Hull, ship.Shields = true, 1, 0
+		game.applyWeaponDamage(ship, 10, killer.ShipID, killer.ShipID, killer.TeamID)
+	}
+
+	destroy()
+	if killer.Kills != 0 || victim.Deaths != 0 {
+		t.Errorf("kills in the lobby should not count: kills %d deaths %d", killer.Kills, victim.Deaths)
+	}
+
+	for _, id := range players {
+		game.SetReady(id, true)
+	}
+	destroy()
+	if killer.Kills != 1 || victim.Deaths != 1 {
+		t.Errorf("kills in the match should count: kills %d deaths %d", killer.Kills, victim.Deaths)
+	}
+}
+
+func TestGame_Tournament_LobbyLeavesPlanetsAlone(t *testing.T) {
+	game, players := newTournamentTestGame(t)
+	raider, _ := game.findPlayerByID(players[2])
+	ship := game.Ships[raider.ShipID]
+	earth := planetNamed(game, "Earth")
+
+	ship.Armies = 20
+	ship.Position = earth.Position
+	if err := game.Orbit(ship.ID); err != nil {
+		t.Fatalf("Orbit failed: %v", err)
+	}
+	if _, err := game.BeamArmies(ship.ID, earth.ID, "down", 20); err == nil {
+		t.Error("expected beaming to fail in the lobby")
+	}
+	torpedo := entity.NewTorpedo(0).CreateProjectile(ship.ID, earth.Position, 0, ship.TeamID)
+	if game.canProjectileBombPlanet(torpedo, earth) {
+		t.Error("planets should not be bombed in the lobby")
+	}
+	if earth.TeamID != 0 || earth.Armies != 10 {
+		t.Errorf("Earth should be untouched by the lobby, team %d armies %d", earth.TeamID, earth.Armies)
+	}
+
+	for _, id := range players {
+		game.SetReady(id, true)
+	}
+	if !game.canProjectileBombPlanet(torpedo, earth) {
+		t.Error("enemy planets should be bombable once the match starts")
+	}
+	if _, err := game.BeamArmies(ship.ID, earth.ID, "down", 20); err != nil {
+		t.Errorf("beaming should work once the match starts: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// pkg/engine/tractor.go
+package engine
+
+import (
+	"errors"
+
+	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+const (
+	// tractorRange is how far apart, surface to surface, a ship and its beam target can be
+	tractorRange = 500.0
+	// tractorForce is the force a beam exerts; each end accelerates by tractorForce / mass
+	tractorForce = 10000.0
+)
+
+// tractorTarget is the ship or planet at the far end of a tractor or pressor beam
+type tractorTarget struct {
+	position physics.Vector2D
+	radius   float64
+	ship     *entity.Ship // nil when the target is a planet, which does not move
+}
+
+// SetTractor locks a tractor beam onto a ship or planet within tractorRange, or a pressor
+// beam when pressor is set. The beam holds each tick until released, out of range or
+// out of fuel, and cloaked enemy ships cannot be targeted.
+func (g *Game) SetTractor(shipID, targetID entity.ID, pressor bool) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	if targetID == ship.ID {
+		return errors.New("ship cannot lock a tractor beam onto itself")
+	}
+	if ship.Cloaked || ship.Fuel <= 0 {
+		return errors.New("ship cannot project a tractor beam")
+	}
+
+	target, ok := g.findTractorTarget(ship, targetID)
+	if !ok {
+		return errors.New("tractor target not found")
+	}
+	if !g.inTractorRange(ship, target) {
+		return errors.New("target out of tractor range")
+	}
+
+	ship.EngageTractor(targetID, pressor)
+	return nil
+}
+
+// ReleaseTractor drops a ship's tractor or pressor beam.
+func (g *Game) ReleaseTractor(shipID entity.ID) error {
+	g.EntityLock.Lock()
+	defer g.EntityLock.Unlock()
+
+	if err := g.checkNotPaused(); err != nil {
+		return err
+	}
+	ship, err := g.findActiveShip(shipID)
+	if err != nil {
+		return err
+	}
+	ship.ReleaseTractor()
+	return nil
+}
+
+// findTractorTarget looks up an active ship or a planet to hold with a beam.
+// Enemy ships hidden by their cloak cannot be found.
+func (g *Game) findTractorTarget(ship *entity.Ship, targetID entity.ID) (tractorTarget, bool) {
+	if other, ok := g.Ships[targetID]; ok {
+		if !other.Active || (other.Cloaked && other.TeamID != ship.TeamID) {
+			return tractorTarget{}, false
+		}
+		return tractorTarget{position: other.Position, radius: other.Collider.Radius, ship: other}, true
+	}
+	if planet, ok := g.Planets[targetID]; ok {
+		return tractorTarget{position: planet.Position, radius: planet.Collider.Radius}, true
+	}
+	return tractorTarget{}, false
+}
+
+// inTractorRange reports whether a beam target's surface is within tractorRange of the ship's.
+func (g *Game) inTractorRange(ship *entity.Ship, target tractorTarget) bool {
+	gap := ship.Position.Distance(target.position) - ship.Collider.Radius - target.radius
+	return gap <= tractorRange
+}
+
+// applyTractorBeams applies the force of every held tractor and pressor beam.
+// Note: Called from within locked context in Update()
+func (g *Game) applyTractorBeams(deltaTime float64) {
+	for _, ship := range g.shipsInOrder() {
+		if ship.Active && ship.Tractor != 0 {
+			g.applyTractorBeam(ship, deltaTime)
+		}
+	}
+}
+
+// applyTractorBeam pulls a ship and its target together, or pushes them apart for a
+// pressor, with equal and opposite forces so the lighter end moves more. A planet
+// target stays put and only the ship moves. Ships caught by a beam are pulled out of
+// orbit or off their starbase, and a beam whose target is gone or out of range is released.
+// Note: Called from within locked context in Update()
+func (g *Game) applyTractorBeam(ship *entity.Ship, deltaTime float64) {
+	target, ok := g.findTractorTarget(ship, ship.Tractor)
+	if !ok || !g.inTractorRange(ship, target) {
+		ship.ReleaseTractor()
+		return
+	}
+
+	offset := target.position.Sub(ship.Position)
+	if offset.Length() == 0 {
+		return
+	}
+	direction := offset.Normalize()
+	if ship.Pressor {
+		direction = direction.Scale(-1)
+	}
+
+	impulse := tractorForce * deltaTime
+	g.leaveOrbit(ship)
+	g.undock(ship)
+	ship.Velocity = ship.Velocity.Add(direction.Scale(impulse / ship.Mass()))
+	if target.ship != nil {
+		g.leaveOrbit(target.ship)
+		g.undock(target.ship)
+		target.ship.Velocity = target.ship.Velocity.Sub(direction.Scale(impulse / target.ship.Mass()))
+	}
+}
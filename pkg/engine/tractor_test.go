@@ -0,0 +1,118 @@
+// Package engine provides unit tests for tractor.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+func TestGame_Tractor_PullsByMass(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	scout, heavy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 3000}, 300)
+	heavy.Stats.MaxHull = scout.Stats.MaxHull * 3
+	fuel := scout.Fuel
+
+	if err := game.SetTractor(scout.ID, heavy.ID, false); err != nil {
+		t.Fatalf("SetTractor failed: %v", err)
+	}
+	game.applyTractorBeams(1.0 / 60.0)
+
+	if scout.Velocity.X <= 0 || heavy.Velocity.X >= 0 {
+		t.Fatalf("tractor should pull both ships together: scout %v, heavy %v", scout.Velocity, heavy.Velocity)
+	}
+	ratio := scout.Velocity.X / -heavy.Velocity.X
+	if want := heavy.Mass() / scout.Mass(); ratio < want-0.01 || ratio > want+0.01 {
+		t.Errorf("lighter ship should move %.2f times faster, got %.2f", want, ratio)
+	}
+
+	for i := 0; i < 60; i++ {
+		game.Update()
+	}
+	if scout.Fuel >= fuel {
+		t.Error("holding a tractor beam should burn fuel")
+	}
+}
+
+func TestGame_Pressor_PushesApart(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	scout, heavy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 3000}, 300)
+	heavy.Stats.MaxHull = scout.Stats.MaxHull * 3
+
+	if err := game.SetTractor(scout.ID, heavy.ID, true); err != nil {
+		t.Fatalf("SetTractor failed: %v", err)
+	}
+	game.applyTractorBeams(1.0 / 60.0)
+
+	if scout.Velocity.X >= 0 || heavy.Velocity.X <= 0 {
+		t.Errorf("pressor should push both ships apart: scout %v, heavy %v", scout.Velocity, heavy.Velocity)
+	}
+}
+
+func TestGame_Tractor_PlanetStaysPut(t *testing.T) {
+	game := NewGame(wideConfig())
+	scout := addShips(t, game, 0)[0]
+	planet := game.Planets[game.planetsInOrder()[0].ID]
+	position := planet.Position
+	moveShip(scout, physics.Vector2D{X: planet.Position.X + planet.Collider.Radius + 200, Y: planet.Position.Y})
+
+	if err := game.SetTractor(scout.ID, planet.ID, false); err != nil {
+		t.Fatalf("SetTractor failed: %v", err)
+	}
+	game.applyTractorBeams(1.0 / 60.0)
+
+	if scout.Velocity.X >= 0 {
+		t.Errorf("tractor on a planet should pull the ship towards it, velocity %v", scout.Velocity)
+	}
+	if planet.Position != position {
+		t.Error("a planet should not move under a tractor beam")
+	}
+}
+
+func TestGame_Tractor_Validation(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	scout, heavy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 3000}, 300)
+	heavy.Stats.MaxHull = scout.Stats.MaxHull * 3
+
+	if err := game.SetTractor(scout.ID, scout.ID, false); err == nil {
+		t.Error("expected error locking a beam onto the ship itself")
+	}
+
+	heavy.Cloaked = true
+	if err := game.SetTractor(scout.ID, heavy.ID, false); err == nil {
+		t.Error("expected error locking onto a cloaked enemy")
+	}
+	heavy.Cloaked = false
+
+	heavy.Position = physics.Vector2D{X: 2000, Y: 3000}
+	if err := game.SetTractor(scout.ID, heavy.ID, false); err == nil {
+		t.Error("expected error locking onto a ship out of range")
+	}
+}
+
+func TestGame_Tractor_ReleasedOutOfRange(t *testing.T) {
+	game := NewGame(wideConfig())
+	ships := addShips(t, game, 0, 1)
+	scout, heavy := ships[0], ships[1]
+	lineUpShips(ships, physics.Vector2D{X: 0, Y: 3000}, 300)
+	heavy.Stats.MaxHull = scout.Stats.MaxHull * 3
+
+	if err := game.SetTractor(scout.ID, heavy.ID, true); err != nil {
+		t.Fatalf("SetTractor failed: %v", err)
+	}
+	heavy.Position = physics.Vector2D{X: 2000, Y: 3000}
+	game.applyTractorBeams(1.0 / 60.0)
+
+	if scout.Tractor != 0 {
+		t.Error("beam should be released once the target is out of range")
+	}
+	if heavy.Velocity.Length() != 0 {
+		t.Error("a released beam should not apply force")
+	}
+}
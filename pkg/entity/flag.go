@@ -0,0 +1,69 @@
+// pkg/entity/flag.go
+package entity
+
+import (
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+// flagRadius is the distance at which a ship touches a flag
+const flagRadius = 60.0
+
+// Flag represents a team's flag in capture the flag. It rests on the team's homeworld
+// until an enemy ship picks it up, then travels with that ship until it is captured,
+// dropped or returned.
+type Flag struct {
+	BaseEntity
+	TeamID    int
+	HomeID    ID               // Homeworld the flag rests on
+	Home      physics.Vector2D // Where the flag is returned to
+	CarrierID ID               // Ship carrying the flag, 0 if none
+}
+
+// NewFlag creates a team's flag resting at its homeworld
+func NewFlag(id ID, teamID int, homeID ID, home physics.Vector2D) *Flag {
+	return &Flag{
+		BaseEntity: BaseEntity{
+			ID:       id,
+			Position: home,
+			Collider: physics.Circle{
+				Center: home,
+				Radius: flagRadius,
+			},
+			Active: true,
+		},
+		TeamID: teamID,
+		HomeID: homeID,
+		Home:   home,
+	}
+}
+
+// AtHome reports whether the flag is resting on its homeworld
+func (f *Flag) AtHome() bool {
+	return f.CarrierID == 0 && f.Position == f.Home
+}
+
+// PickUp hands the flag to a ship, which carries it until it is dropped
+func (f *Flag) PickUp(ship *Ship) {
+	f.CarrierID = ship.ID
+	ship.CarryingFlag = f.ID
+	f.Position = ship.Position
+}
+
+// Drop leaves the flag where its carrier is, clearing the carrier's hold on it
+func (f *Flag) Drop(carrier *Ship) {
+	if carrier != nil && carrier.CarryingFlag == f.ID {
+		carrier.CarryingFlag = 0
+	}
+	f.CarrierID = 0
+}
+
+// Return puts the flag back on its homeworld
+func (f *Flag) Return(carrier *Ship) {
+	f.Drop(carrier)
+	f.Position = f.Home
+}
+
+// Touches reports whether a ship is close enough to pick up or return the flag
+func (f *Flag) Touches(ship *Ship) bool {
+	return ship.Position.Distance(f.Position) <= f.Collider.Radius+ship.Collider.Radius
+}
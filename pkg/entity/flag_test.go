@@ -0,0 +1,46 @@
+// flag_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/opd-ai/go-netrek/pkg/physics"
+)
+
+func TestFlag_PickUpDropReturn(t *testing.T) {
+	home := physics.Vector2D{X: 100, Y: 100}
+	flag := NewFlag(1, 0, 2, home)
+	ship := NewShip(3, Scout, 1, physics.Vector2D{X: 150, Y: 100})
+
+	if !flag.AtHome() || !flag.Touches(ship) {
+		t.Fatal("a new flag should rest at home and be touched by a ship beside it")
+	}
+
+	flag.PickUp(ship)
+	if flag.CarrierID != ship.ID || ship.CarryingFlag != flag.ID || flag.AtHome() {
+		t.Fatal("picking up should hand the flag to the ship")
+	}
+
+	ship.Position = physics.Vector2D{X: 500, Y: 500}
+	flag.Position = ship.Position
+	flag.Drop(ship)
+	if flag.CarrierID != 0 || ship.CarryingFlag != 0 || flag.Position != ship.Position {
+		t.Error("a dropped flag should stay where its carrier left it")
+	}
+
+	flag.Return(nil)
+	if !flag.AtHome() {
+		t.Error("a returned flag should be back home")
+	}
+}
+
+func TestShip_FlagCarrierSlowed(t *testing.T) {
+	ship := NewShip(1, Scout, 0, physics.Vector2D{})
+	if ship.maxSpeed() != ship.Stats.MaxSpeed {
+		t.Fatal("an unladen ship should reach its full top speed")
+	}
+	ship.CarryingFlag = 2
+	if ship.maxSpeed() >= ship.Stats.MaxSpeed {
+		t.Error("a flag carrier should be slower than its full top speed")
+	}
+}
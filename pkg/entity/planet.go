@@ -2,6 +2,8 @@
 package entity
 
 import (
+	"math"
+
 	"github.com/opd-ai/go-netrek/pkg/physics"
 )
 
@@ -27,8 +29,27 @@ type Planet struct {
 	Temperature int  // Affects bombing effectiveness
 	Atmosphere  bool // Affects bombing
 	MaxArmies   int
+
+	// Defense is the weapon the planet fires at nearby enemy ships, nil if it has none
+	Defense Weapon
+
+	// armyProgress holds fractional armies produced until they add up to a whole army
+	armyProgress float64
+	// defenseCooldown is the seconds until the planet's defenses can fire again
+	defenseCooldown float64
 }
 
+const (
+	// agriculturalGrowthBonus multiplies army growth on agricultural planets
+	agriculturalGrowthBonus = 2.0
+	// defenseInterval is the seconds between shots from a planet's defenses
+	defenseInterval = 2.0
+	// defenseMuzzleGap is how far above the surface a planet's defensive shots are launched
+	defenseMuzzleGap = 10.0
+	// defenseFullArmies is the garrison at which a planet's defenses reach full strength
+	defenseFullArmies = 20
+)
+
 // NewPlanet creates a new planet
 func NewPlanet(id ID, name string, position physics.Vector2D, planetType PlanetType) *Planet {
 	planet := &Planet{
@@ -68,24 +89,100 @@ func NewPlanet(id ID, name string, position physics.Vector2D, planetType PlanetT
 		planet.MaxArmies = 200
 		planet.Production = 12
 	}
+	planet.Defense = NewTorpedo(id)
 
 	return planet
 }
 
+// Mass returns the planet's gravitational mass, which depends on its type
+func (p *Planet) Mass() float64 {
+	switch p.Type {
+	case Agricultural:
+		return 80000
+	case Industrial:
+		return 100000
+	case Military:
+		return 120000
+	case Homeworld:
+		return 200000
+	default:
+		return 100000
+	}
+}
+
 // Update handles the planet's state update for a single game tick
 func (p *Planet) Update(deltaTime float64) {
-	// Planets don't move, but they produce armies
-	if p.TeamID >= 0 { // If owned by a team
-		productionRate := float64(p.Production) * deltaTime / 10.0 // Armies per second
-		newArmies := int(productionRate)
-
-		if newArmies > 0 && p.Armies < p.MaxArmies {
-			p.Armies += newArmies
-			if p.Armies > p.MaxArmies {
-				p.Armies = p.MaxArmies
-			}
-		}
+	if p.defenseCooldown > 0 {
+		p.defenseCooldown -= deltaTime
 	}
+
+	// Planets don't move, but owned planets produce armies
+	if p.TeamID < 0 || p.Armies >= p.MaxArmies {
+		p.armyProgress = 0
+		return
+	}
+	p.armyProgress += p.GrowthRate() * deltaTime
+	whole := int(p.armyProgress)
+	p.armyProgress -= float64(whole)
+	p.Armies += whole
+	if p.Armies > p.MaxArmies {
+		p.Armies = p.MaxArmies
+	}
+}
+
+// GrowthRate returns the armies per second the planet produces while owned.
+// Agricultural planets grow armies faster.
+func (p *Planet) GrowthRate() float64 {
+	rate := float64(p.Production) / 10.0
+	if p.Type == Agricultural {
+		rate *= agriculturalGrowthBonus
+	}
+	return rate
+}
+
+// HasShipyard reports whether ships orbiting the planet can be repaired and refitted.
+// Industrial planets and homeworlds have shipyards.
+func (p *Planet) HasShipyard() bool {
+	return p.Type == Industrial || p.Type == Homeworld
+}
+
+// DefenseStrength returns the multiplier applied to the damage of the planet's defensive
+// fire. It grows with the garrison up to defenseFullArmies and depends on the planet's
+// type: military planets hit hardest and agricultural planets weakest. An empty planet
+// cannot fire.
+func (p *Planet) DefenseStrength() float64 {
+	garrison := math.Min(float64(p.Armies)/defenseFullArmies, 1)
+	if garrison <= 0 {
+		return 0
+	}
+
+	switch p.Type {
+	case Agricultural:
+		return garrison * 0.5
+	case Military:
+		return garrison * 2.0
+	case Homeworld:
+		return garrison * 1.5
+	default:
+		return garrison
+	}
+}
+
+// FireDefense fires the planet's defensive weapon at a target position, returning the
+// projectile, or nil if the planet is neutral, ungarrisoned, has no defenses or is still
+// reloading. The shot's damage is scaled by DefenseStrength.
+func (p *Planet) FireDefense(target physics.Vector2D) *Projectile {
+	strength := p.DefenseStrength()
+	if p.Defense == nil || p.TeamID < 0 || strength <= 0 || p.defenseCooldown > 0 {
+		return nil
+	}
+	angle := target.Sub(p.Position).Angle()
+	muzzle := p.Position.Add(physics.FromAngle(angle, p.Collider.Radius+defenseMuzzleGap))
+
+	p.defenseCooldown = defenseInterval
+	proj := p.Defense.CreateProjectile(p.ID, muzzle, angle, p.TeamID)
+	proj.Damage = int(math.Max(math.Round(float64(proj.Damage)*strength), 1))
+	return proj
 }
 
 // Bomb reduces the number of armies on the planet
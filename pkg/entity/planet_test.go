@@ -58,6 +58,100 @@ func TestPlanet_Update_Production(t *testing.T) {
 	}
 }
 
+func TestPlanet_Update_AccumulatesFractionalProduction(t *testing.T) {
+	p := NewPlanet(1, "Vulcan", physics.Vector2D{X: 0, Y: 0}, Industrial)
+	p.TeamID = 0
+	p.Armies = 10
+	p.Production = 10 // One army per second
+
+	for i := 0; i < 60; i++ {
+		p.Update(1.0 / 60.0)
+	}
+	if p.Armies < 10 || p.Armies > 11 || (p.Armies == 10 && p.armyProgress < 0.99) {
+		t.Errorf("expected about one army after one second of 60 ticks, got %d (+%.2f)", p.Armies, p.armyProgress)
+	}
+}
+
+func TestPlanet_GrowthRate_AgriculturalBonus(t *testing.T) {
+	agri := NewPlanet(1, "Ceres", physics.Vector2D{}, Agricultural)
+	industrial := NewPlanet(2, "Titan", physics.Vector2D{}, Industrial)
+	agri.Production, industrial.Production = 10, 10
+
+	if agri.GrowthRate() != industrial.GrowthRate()*agriculturalGrowthBonus {
+		t.Errorf("agricultural growth %.2f should be %.0fx industrial %.2f",
+			agri.GrowthRate(), agriculturalGrowthBonus, industrial.GrowthRate())
+	}
+}
+
+func TestPlanet_HasShipyard(t *testing.T) {
+	for ptype, want := range map[PlanetType]bool{Agricultural: false, Industrial: true, Military: false, Homeworld: true} {
+		if got := NewPlanet(1, "P", physics.Vector2D{}, ptype).HasShipyard(); got != want {
+			t.Errorf("planet type %d: HasShipyard() = %v, want %v", ptype, got, want)
+		}
+	}
+}
+
+func TestPlanet_FireDefense(t *testing.T) {
+	p := NewPlanet(1, "Qo'noS", physics.Vector2D{}, Military)
+	target := physics.Vector2D{X: 300, Y: 0}
+
+	if p.FireDefense(target) != nil {
+		t.Error("a neutral planet should not fire")
+	}
+
+	p.TeamID = 1
+	proj := p.FireDefense(target)
+	if proj == nil {
+		t.Fatal("an owned military planet should fire")
+	}
+	if proj.OwnerID != p.ID || proj.TeamID != 1 || proj.Velocity.X <= 0 {
+		t.Errorf("expected a shot from the planet towards the target, got %+v", proj)
+	}
+	if proj.Position.Distance(p.Position) <= p.Collider.Radius+proj.Collider.Radius {
+		t.Error("the shot should be launched clear of the planet's surface")
+	}
+	if p.FireDefense(target) != nil {
+		t.Error("the planet should reload between shots")
+	}
+
+	p.Update(defenseInterval)
+	if p.FireDefense(target) == nil {
+		t.Error("the planet should fire again once reloaded")
+	}
+
+	p.Update(defenseInterval)
+	p.Armies = 0
+	if p.FireDefense(target) != nil {
+		t.Error("a planet without armies should not fire")
+	}
+}
+
+func TestPlanet_DefenseStrength(t *testing.T) {
+	military := NewPlanet(1, "Qo'noS", physics.Vector2D{}, Military)
+	farm := NewPlanet(2, "Ceres", physics.Vector2D{}, Agricultural)
+	military.Armies, farm.Armies = defenseFullArmies, defenseFullArmies
+
+	if military.DefenseStrength() <= farm.DefenseStrength() {
+		t.Error("a military planet should defend more strongly than an agricultural one")
+	}
+
+	full := military.DefenseStrength()
+	military.Armies = defenseFullArmies * 2
+	if military.DefenseStrength() != full {
+		t.Error("defense strength should stop growing at a full garrison")
+	}
+	military.Armies = defenseFullArmies / 2
+	if military.DefenseStrength() >= full {
+		t.Error("a smaller garrison should weaken the planet's defenses")
+	}
+
+	military.TeamID, farm.TeamID = 1, 1
+	strong, weak := military.FireDefense(physics.Vector2D{X: 300}), farm.FireDefense(physics.Vector2D{X: 300})
+	if strong.Damage <= weak.Damage {
+		t.Errorf("stronger defenses should hit harder: %d vs %d", strong.Damage, weak.Damage)
+	}
+}
+
 func TestPlanet_Update_NoProductionWhenNeutral(t *testing.T) {
 	p := NewPlanet(2, "Pluto", physics.Vector2D{X: 0, Y: 0}, Agricultural)
 	p.TeamID = -1 // Neutral
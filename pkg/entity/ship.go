@@ -2,6 +2,7 @@
 package entity
 
 import (
+	"math"
 	"runtime"
 	"time"
 
@@ -18,18 +19,21 @@ const (
 	Cruiser
 	Battleship
 	Assault
+	Starbase // Slow, heavily armed base that teammates dock with; one per team
 )
 
 // ShipStats contains the base statistics for a ship class
 type ShipStats struct {
-	MaxHull      int
-	MaxShields   int
-	MaxFuel      int
-	Acceleration float64
-	TurnRate     float64
-	MaxSpeed     float64
-	WeaponSlots  int
-	MaxArmies    int
+	MaxHull        int
+	MaxShields     int
+	MaxFuel        int
+	Acceleration   float64
+	TurnRate       float64
+	MaxSpeed       float64
+	WeaponSlots    int
+	MaxArmies      int
+	WarpMultiplier float64 // Factor applied to MaxSpeed and Acceleration while warping; 0 means no warp drive
+	WarpFuelRate   float64 // Fuel burned per second while warping
 }
 
 // Ship represents a player's spaceship in the Netrek game
@@ -45,7 +49,7 @@ type Ship struct {
 	Weapons        []Weapon
 	Armies         int
 	Cloaked        bool
-	LastFired      map[string]time.Time
+	Cooldowns      map[string]float64 // Seconds until each weapon can fire again, counted down per tick
 	Thrusting      bool
 	TurningCW      bool
 	TurningCCW     bool
@@ -54,8 +58,81 @@ type Ship struct {
 	Warping        bool
 	LastDamageTime time.Time
 	LastRepairTime time.Time
+
+	// ShieldsUp reports whether the shields are raised; raised shields absorb damage and burn fuel
+	ShieldsUp bool
+
+	// EngineTemp is the engine temperature from 0 to maxEngineTemp, raised by thrust and warp
+	EngineTemp float64
+	// EngineOverheated locks out thrust and warp until the engine cools to engineRecoverTemp
+	EngineOverheated bool
+	// WeaponTemp is the weapon temperature from 0 to maxWeaponTemp, raised by each shot
+	WeaponTemp float64
+	// WeaponsOverheated locks out all weapons until they cool to weaponRecoverTemp
+	WeaponsOverheated bool
+	// Orbiting is the ID of the planet the ship is locked in orbit around, 0 if none
+	Orbiting ID
+	// DockedTo is the ID of the friendly starbase the ship is docked with, 0 if none
+	DockedTo ID
+	// Tractor is the ID of the ship or planet held by the tractor beam, 0 if none
+	Tractor ID
+	// Pressor reports whether the beam on Tractor pushes it away rather than pulling it in
+	Pressor bool
+	// CarryingFlag is the ID of the enemy flag the ship is carrying in capture the flag, 0 if none
+	CarryingFlag ID
+
+	// fuelDrain holds fractional fuel owed by per-second drains until it adds up to a whole unit
+	fuelDrain float64
+	// repairProgress holds fractional hull repaired until it adds up to a whole point
+	repairProgress float64
+	// shieldProgress holds fractional shield strength regenerated until it adds up to a whole point
+	shieldProgress float64
 }
 
+const (
+	// cloakFuelPerSecond is the fuel spent each second to keep the cloaking device running
+	cloakFuelPerSecond = 20.0
+	// tractorFuelPerSecond is the fuel spent each second to hold a tractor or pressor beam
+	tractorFuelPerSecond = 10.0
+
+	// shieldFuelPerSecond is the fuel spent each second to keep the shields raised
+	shieldFuelPerSecond = 2.0
+	// shieldRegenPerSecond is how fast raised shields recharge
+	shieldRegenPerSecond = 5.0
+
+	// maxEngineTemp is the engine temperature at which the engines shut down
+	maxEngineTemp = 100.0
+	// engineRecoverTemp is the temperature an overheated engine must cool to before running again
+	engineRecoverTemp = 50.0
+	// warpHeatPerSecond is how fast warping heats the engine
+	warpHeatPerSecond = 10.0
+	// thrustHeatPerSecond is how fast thrusting heats the engine
+	thrustHeatPerSecond = 2.0
+	// engineCoolingPerSecond is how fast the engine cools when neither thrusting nor warping
+	engineCoolingPerSecond = 5.0
+
+	// maxWeaponTemp is the weapon temperature at which all weapons lock out
+	maxWeaponTemp = 100.0
+	// weaponRecoverTemp is the temperature overheated weapons must cool to before firing again
+	weaponRecoverTemp = 50.0
+	// weaponCoolingPerSecond is how fast the weapons cool
+	weaponCoolingPerSecond = 10.0
+
+	// flagCarrierSpeedFactor is the fraction of its top speed a ship keeps while carrying a flag
+	flagCarrierSpeedFactor = 0.7
+
+	// explosionBaseRadius is the blast radius of a destroyed ship before scaling by its hull
+	explosionBaseRadius = 60.0
+	// explosionRadiusPerHull is the blast radius added per point of maximum hull
+	explosionRadiusPerHull = 0.3
+	// explosionDamagePerHull is the damage at the centre of a blast per point of maximum hull
+	explosionDamagePerHull = 0.25
+	// starbaseExplosionBonus multiplies the blast of a destroyed starbase
+	starbaseExplosionBonus = 1.5
+	// armyExplosionBonus is the extra blast, as a fraction, from a full load of armies
+	armyExplosionBonus = 0.5
+)
+
 // NewShip creates a new ship with the specified class and team
 func NewShip(id ID, class ShipClass, teamID int, position physics.Vector2D) *Ship {
 	caller := getShipCallerInfo()
@@ -98,8 +175,9 @@ func NewShip(id ID, class ShipClass, teamID int, position physics.Vector2D) *Shi
 		Hull:      stats.MaxHull,
 		Shields:   stats.MaxShields,
 		Fuel:      stats.MaxFuel,
+		ShieldsUp: true,
 		Weapons:   make([]Weapon, 0, stats.WeaponSlots),
-		LastFired: make(map[string]time.Time),
+		Cooldowns: make(map[string]float64),
 	}
 
 	logger.WithField("caller", caller).WithFields(logrus.Fields{
@@ -112,31 +190,34 @@ func NewShip(id ID, class ShipClass, teamID int, position physics.Vector2D) *Shi
 		"active":          ship.Active,
 	}).Debug("Ship base structure initialized")
 
-	// Add default weapons
-	logger.WithField("caller", caller).WithField("function", "NewShip").Debug("Adding default weapons")
-	torpedoWeapon := NewTorpedo(id)
-	ship.Weapons = append(ship.Weapons, torpedoWeapon)
-	logger.WithField("caller", caller).WithFields(logrus.Fields{
-		"function":    "NewShip",
-		"ship_id":     id,
-		"weapon_type": "torpedo",
-		"weapon_name": torpedoWeapon.GetName(),
-	}).Debug("Torpedo weapon added")
-
-	phaserWeapon := NewPhaser(id)
-	ship.Weapons = append(ship.Weapons, phaserWeapon)
-	logger.WithField("caller", caller).WithFields(logrus.Fields{
-		"function":    "NewShip",
-		"ship_id":     id,
-		"weapon_type": "phaser",
-		"weapon_name": phaserWeapon.GetName(),
-	}).Debug("Phaser weapon added")
+	// Mount the class loadout, up to the number of weapon slots
+	logger.WithField("caller", caller).WithField("function", "NewShip").Debug("Adding loadout weapons")
+	for _, name := range getShipLoadout(class, stats) {
+		if len(ship.Weapons) >= stats.WeaponSlots {
+			break
+		}
+		weapon := NewWeapon(name, id)
+		if weapon == nil {
+			logger.WithField("caller", caller).WithFields(logrus.Fields{
+				"function":    "NewShip",
+				"ship_id":     id,
+				"weapon_name": name,
+			}).Warn("Unknown weapon in loadout, skipping")
+			continue
+		}
+		ship.Weapons = append(ship.Weapons, weapon)
+		logger.WithField("caller", caller).WithFields(logrus.Fields{
+			"function":    "NewShip",
+			"ship_id":     id,
+			"weapon_name": weapon.GetName(),
+		}).Debug("Weapon added")
+	}
 
 	logger.WithField("caller", caller).WithFields(logrus.Fields{
 		"function":      "NewShip",
 		"ship_id":       id,
 		"total_weapons": len(ship.Weapons),
-	}).Info("Ship created successfully with default weapons")
+	}).Info("Ship created successfully with loadout weapons")
 
 	return ship
 }
@@ -152,10 +233,152 @@ func getShipCallerInfo() string {
 // Update handles the ship's state update for a single game tick
 func (s *Ship) Update(deltaTime float64) {
 	s.updateRotation(deltaTime)
+	s.updateWarp(deltaTime)
 	s.updateAcceleration(deltaTime)
 	s.applyDrag(deltaTime)
 	s.BaseEntity.Update(deltaTime)
-	s.regenerateShields(deltaTime)
+	s.updateShields(deltaTime)
+	s.updateCooldowns(deltaTime)
+	s.updateWeaponTemp(deltaTime)
+	s.updateCloak(deltaTime)
+	s.updateTractor(deltaTime)
+}
+
+// SetShields raises or lowers the shields. A ship without fuel cannot raise them.
+func (s *Ship) SetShields(up bool) {
+	s.ShieldsUp = up && s.Fuel > 0
+}
+
+// SetCloak engages or disengages the cloaking device. A ship without fuel cannot cloak.
+func (s *Ship) SetCloak(on bool) {
+	s.Cloaked = on && s.Fuel > 0
+}
+
+// EngageTractor locks a tractor beam onto a target, or a pressor beam if pressor is set.
+// A cloaked ship or one without fuel cannot project a beam.
+func (s *Ship) EngageTractor(target ID, pressor bool) {
+	if target == 0 || s.Cloaked || s.Fuel <= 0 {
+		s.ReleaseTractor()
+		return
+	}
+	s.Tractor = target
+	s.Pressor = pressor
+}
+
+// ReleaseTractor drops any tractor or pressor beam the ship is holding.
+func (s *Ship) ReleaseTractor() {
+	s.Tractor = 0
+	s.Pressor = false
+}
+
+// SetWarp engages or disengages the warp drive. Warp needs a warp-capable class,
+// fuel in the tank and an engine that is not overheated.
+func (s *Ship) SetWarp(on bool) {
+	s.Warping = on && s.Stats.WarpMultiplier > 0 && s.Fuel > 0 && !s.EngineOverheated
+}
+
+// updateWarp burns fuel while warping and updates the engine temperature.
+// The warp drive drops out when the tank runs dry or the engine overheats.
+func (s *Ship) updateWarp(deltaTime float64) {
+	if s.Warping && !s.drainFuel(s.Stats.WarpFuelRate, deltaTime) {
+		s.Warping = false
+	}
+	s.updateEngineTemp(deltaTime)
+}
+
+// updateEngineTemp heats the engine while thrusting or warping and cools it otherwise.
+// An overheated engine shuts down thrust and warp until it has cooled.
+func (s *Ship) updateEngineTemp(deltaTime float64) {
+	heat := 0.0
+	if s.Warping {
+		heat += warpHeatPerSecond
+	}
+	if s.Thrusting && s.Fuel > 0 && !s.EngineOverheated {
+		heat += thrustHeatPerSecond
+	}
+
+	if heat > 0 {
+		s.EngineTemp += heat * deltaTime
+		if s.EngineTemp >= maxEngineTemp {
+			s.EngineTemp = maxEngineTemp
+			s.EngineOverheated = true
+			s.Warping = false
+		}
+		return
+	}
+
+	s.EngineTemp -= engineCoolingPerSecond * deltaTime
+	if s.EngineTemp < 0 {
+		s.EngineTemp = 0
+	}
+	if s.EngineOverheated && s.EngineTemp <= engineRecoverTemp {
+		s.EngineOverheated = false
+	}
+}
+
+// maxSpeed returns the ship's current top speed, raised while warping and lowered
+// while carrying a flag
+func (s *Ship) maxSpeed() float64 {
+	speed := s.Stats.MaxSpeed
+	if s.Warping {
+		speed *= s.Stats.WarpMultiplier
+	}
+	if s.CarryingFlag != 0 {
+		speed *= flagCarrierSpeedFactor
+	}
+	return speed
+}
+
+// acceleration returns the ship's current acceleration, raised while warping
+func (s *Ship) acceleration() float64 {
+	if s.Warping {
+		return s.Stats.Acceleration * s.Stats.WarpMultiplier
+	}
+	return s.Stats.Acceleration
+}
+
+// updateCloak drains fuel while cloaked and drops the cloak when the tank runs dry
+func (s *Ship) updateCloak(deltaTime float64) {
+	if s.Cloaked && !s.drainFuel(cloakFuelPerSecond, deltaTime) {
+		s.Cloaked = false
+	}
+}
+
+// updateTractor burns fuel while a beam is held, dropping it when the tank runs dry
+// or the ship cloaks.
+func (s *Ship) updateTractor(deltaTime float64) {
+	if s.Tractor == 0 {
+		return
+	}
+	if s.Cloaked || !s.drainFuel(tractorFuelPerSecond, deltaTime) {
+		s.ReleaseTractor()
+	}
+}
+
+// drainFuel consumes fuel at a per-second rate, carrying fractions over between ticks.
+// It returns false once the tank is empty.
+func (s *Ship) drainFuel(perSecond, deltaTime float64) bool {
+	s.fuelDrain += perSecond * deltaTime
+	whole := int(s.fuelDrain)
+	s.fuelDrain -= float64(whole)
+	s.Fuel -= whole
+	if s.Fuel <= 0 {
+		s.Fuel = 0
+		return false
+	}
+	return true
+}
+
+// updateCooldowns counts weapon cooldowns down by one tick's worth of time
+func (s *Ship) updateCooldowns(deltaTime float64) {
+	for name, remaining := range s.Cooldowns {
+		remaining -= deltaTime
+		if remaining <= 0 {
+			delete(s.Cooldowns, name)
+		} else {
+			s.Cooldowns[name] = remaining
+		}
+	}
 }
 
 // updateRotation processes ship rotation based on turn input
@@ -170,14 +393,14 @@ func (s *Ship) updateRotation(deltaTime float64) {
 
 // updateAcceleration handles thrust input, acceleration calculation, speed limiting, and fuel consumption
 func (s *Ship) updateAcceleration(deltaTime float64) {
-	if s.Thrusting && s.Fuel > 0 {
+	if s.Thrusting && s.Fuel > 0 && !s.EngineOverheated {
 		// Calculate acceleration vector based on ship heading
-		accelVector := physics.FromAngle(s.Rotation, s.Stats.Acceleration)
+		accelVector := physics.FromAngle(s.Rotation, s.acceleration())
 		s.Velocity = s.Velocity.Add(accelVector.Scale(deltaTime))
 
 		// Cap speed at max speed
-		if s.Velocity.Length() > s.Stats.MaxSpeed {
-			s.Velocity = s.Velocity.Normalize().Scale(s.Stats.MaxSpeed)
+		if s.Velocity.Length() > s.maxSpeed() {
+			s.Velocity = s.Velocity.Normalize().Scale(s.maxSpeed())
 		}
 
 		// Consume fuel
@@ -191,50 +414,135 @@ func (s *Ship) applyDrag(deltaTime float64) {
 	s.Velocity = s.Velocity.Scale(1.0 - drag*deltaTime)
 }
 
-// regenerateShields increases shield strength over time up to maximum capacity
+// regenerateShields increases shield strength over time up to maximum capacity.
+// Shields only recharge while raised, and are offline while warping.
 func (s *Ship) regenerateShields(deltaTime float64) {
+	if s.Warping || !s.ShieldsUp {
+		return
+	}
 	if s.Shields < s.Stats.MaxShields {
-		s.Shields += int(deltaTime * 5) // Adjust shield regen rate
+		s.shieldProgress += shieldRegenPerSecond * deltaTime
+		whole := int(s.shieldProgress)
+		s.shieldProgress -= float64(whole)
+		s.Shields += whole
 		if s.Shields > s.Stats.MaxShields {
 			s.Shields = s.Stats.MaxShields
+			s.shieldProgress = 0
 		}
 	}
 }
 
+// updateShields burns fuel to keep the shields raised, dropping them when the tank
+// runs dry, and recharges them. Shields are offline while warping, so they burn nothing.
+func (s *Ship) updateShields(deltaTime float64) {
+	if s.ShieldsUp && !s.Warping && !s.drainFuel(shieldFuelPerSecond, deltaTime) {
+		s.ShieldsUp = false
+	}
+	s.regenerateShields(deltaTime)
+}
+
+// updateWeaponTemp cools the weapons and lifts an overheat lockout once they have cooled
+func (s *Ship) updateWeaponTemp(deltaTime float64) {
+	s.WeaponTemp -= weaponCoolingPerSecond * deltaTime
+	if s.WeaponTemp < 0 {
+		s.WeaponTemp = 0
+	}
+	if s.WeaponsOverheated && s.WeaponTemp <= weaponRecoverTemp {
+		s.WeaponsOverheated = false
+	}
+}
+
 // FireWeapon attempts to fire the specified weapon
 func (s *Ship) FireWeapon(weaponIndex int) *Projectile {
-	if weaponIndex < 0 || weaponIndex >= len(s.Weapons) {
+	return s.FireWeaponAt(weaponIndex, s.Rotation)
+}
+
+// FireWeaponAt fires a projectile weapon at the given angle. Hitscan weapons create
+// no projectile and are fired with FireHitscan instead.
+func (s *Ship) FireWeaponAt(weaponIndex int, angle float64) *Projectile {
+	weapon, ok := s.readyWeapon(weaponIndex)
+	if !ok {
 		return nil
 	}
+	if _, hitscan := weapon.(HitscanWeapon); hitscan {
+		return nil
+	}
+
+	// Create projectile
+	projectile := weapon.CreateProjectile(s.ID, s.Position, angle, s.TeamID)
+	s.spendWeapon(weapon)
+
+	return projectile
+}
+
+// FireHitscan fires a hitscan weapon, starting its cooldown and spending its fuel.
+// The caller resolves the shot along a ray.
+func (s *Ship) FireHitscan(weaponIndex int) (HitscanWeapon, bool) {
+	weapon, ok := s.readyWeapon(weaponIndex)
+	if !ok {
+		return nil, false
+	}
+	hitscan, ok := weapon.(HitscanWeapon)
+	if !ok {
+		return nil, false
+	}
+	s.spendWeapon(weapon)
+	return hitscan, true
+}
+
+// IsHitscanWeapon reports whether the weapon in a slot is resolved along a ray
+func (s *Ship) IsHitscanWeapon(weaponIndex int) bool {
+	if weaponIndex < 0 || weaponIndex >= len(s.Weapons) {
+		return false
+	}
+	_, hitscan := s.Weapons[weaponIndex].(HitscanWeapon)
+	return hitscan
+}
+
+// readyWeapon returns the weapon in a slot if it can fire now
+func (s *Ship) readyWeapon(weaponIndex int) (Weapon, bool) {
+	if weaponIndex < 0 || weaponIndex >= len(s.Weapons) {
+		return nil, false
+	}
+
+	// Weapons cannot be fired through the cloak or while overheated
+	if s.Cloaked || s.WeaponsOverheated {
+		return nil, false
+	}
 
 	weapon := s.Weapons[weaponIndex]
-	now := time.Now()
 
 	// Check cooldown
-	lastFired, exists := s.LastFired[weapon.GetName()]
-	if exists && now.Sub(lastFired) < weapon.GetCooldown() {
-		return nil // Weapon still on cooldown
+	if s.Cooldowns[weapon.GetName()] > 0 {
+		return nil, false // Weapon still on cooldown
 	}
 
 	// Check fuel/energy requirements
 	if s.Fuel < weapon.GetFuelCost() {
-		return nil // Not enough fuel
+		return nil, false // Not enough fuel
 	}
 
-	// Create projectile
-	projectile := weapon.CreateProjectile(s.ID, s.Position, s.Rotation, s.TeamID)
+	return weapon, true
+}
 
-	// Update cooldown and consume resources
-	s.LastFired[weapon.GetName()] = now
+// spendWeapon starts a weapon's cooldown, consumes its fuel cost and heats the weapons.
+// Each shot adds heat equal to its fuel cost, so heavier weapons overheat sooner.
+func (s *Ship) spendWeapon(weapon Weapon) {
+	s.Cooldowns[weapon.GetName()] = weapon.GetCooldown().Seconds()
 	s.Fuel -= weapon.GetFuelCost()
 
-	return projectile
+	s.WeaponTemp += float64(weapon.GetFuelCost())
+	if s.WeaponTemp >= maxWeaponTemp {
+		s.WeaponTemp = maxWeaponTemp
+		s.WeaponsOverheated = true
+	}
 }
 
-// TakeDamage applies damage to the ship, first to shields then to hull
+// TakeDamage applies damage to the ship, first to shields then to hull.
+// Lowered shields, and shields while warping, let all damage through to the hull.
 func (s *Ship) TakeDamage(amount int) bool {
 	// Apply to shields first
-	if s.Shields > 0 {
+	if s.Shields > 0 && s.ShieldsUp && !s.Warping {
 		if s.Shields >= amount {
 			s.Shields -= amount
 			amount = 0
@@ -253,29 +561,79 @@ func (s *Ship) TakeDamage(amount int) bool {
 	return s.Hull <= 0
 }
 
+// Mass returns the ship's mass for collision and force calculations, derived from its hull strength
+func (s *Ship) Mass() float64 {
+	if s.Stats.MaxHull <= 0 {
+		return 1
+	}
+	return float64(s.Stats.MaxHull)
+}
+
+// Explosion returns the blast radius and centre damage of the ship's explosion when it
+// is destroyed. Both grow with the ship's maximum hull, are larger for a starbase and
+// grow further with the armies aboard, up to armyExplosionBonus for a full load.
+func (s *Ship) Explosion() (radius float64, damage int) {
+	scale := 1.0
+	if s.Class == Starbase {
+		scale *= starbaseExplosionBonus
+	}
+	if s.Stats.MaxArmies > 0 && s.Armies > 0 {
+		scale *= 1 + armyExplosionBonus*math.Min(float64(s.Armies)/float64(s.Stats.MaxArmies), 1)
+	}
+
+	hull := float64(s.Stats.MaxHull)
+	radius = (explosionBaseRadius + hull*explosionRadiusPerHull) * scale
+	damage = int(math.Round(hull * explosionDamagePerHull * scale))
+	return radius, damage
+}
+
+// RepairTick repairs 10% of the hull per second while in repair mode, burning fuel.
+// Repair mode turns off when the tank runs dry.
 func (s *Ship) RepairTick(deltaTime float64) {
 	if !s.RepairMode {
 		return
 	}
 
-	repairRate := float64(s.Stats.MaxHull) * 0.1 * deltaTime // 10% per second
-
 	// Repair hull
 	if s.Hull < s.Stats.MaxHull {
-		s.Hull += int(repairRate)
+		s.repairProgress += float64(s.Stats.MaxHull) * 0.1 * deltaTime // 10% per second
+		whole := int(s.repairProgress)
+		s.repairProgress -= float64(whole)
+		s.Hull += whole
 		if s.Hull > s.Stats.MaxHull {
 			s.Hull = s.Stats.MaxHull
+			s.repairProgress = 0
 		}
 	}
 
 	// Consume fuel while repairing
-	s.Fuel -= int(deltaTime * 5) // 5 fuel per second
-	if s.Fuel < 0 {
-		s.Fuel = 0
+	if !s.drainFuel(5, deltaTime) { // 5 fuel per second
 		s.RepairMode = false
 	}
 }
 
+// Rearm readies every weapon at once, clearing cooldowns and venting weapon heat.
+func (s *Ship) Rearm() {
+	for name := range s.Cooldowns {
+		delete(s.Cooldowns, name)
+	}
+	s.WeaponTemp = 0
+	s.WeaponsOverheated = false
+}
+
+// Refuel adds fuel at a per-second rate up to the tank's capacity, carrying fractions
+// over between ticks.
+func (s *Ship) Refuel(perSecond, deltaTime float64) {
+	s.fuelDrain -= perSecond * deltaTime
+	whole := int(-s.fuelDrain)
+	s.fuelDrain += float64(whole)
+	s.Fuel += whole
+	if s.Fuel >= s.Stats.MaxFuel {
+		s.Fuel = s.Stats.MaxFuel
+		s.fuelDrain = 0
+	}
+}
+
 var shipTypeStats map[string]ShipStats
 
 // SetShipTypeStats allows the config loader to inject custom ship stats
@@ -294,70 +652,95 @@ func getShipStats(class ShipClass) ShipStats {
 	switch class {
 	case Scout:
 		return ShipStats{
-			MaxHull:      100,
-			MaxShields:   100,
-			MaxFuel:      1000,
-			Acceleration: 200,
-			TurnRate:     3.0,
-			MaxSpeed:     300,
-			WeaponSlots:  2,
-			MaxArmies:    2,
+			MaxHull:        100,
+			MaxShields:     100,
+			MaxFuel:        1000,
+			Acceleration:   200,
+			TurnRate:       3.0,
+			MaxSpeed:       300,
+			WeaponSlots:    2,
+			MaxArmies:      2,
+			WarpMultiplier: 2.0,
+			WarpFuelRate:   40,
 		}
 	case Destroyer:
 		return ShipStats{
-			MaxHull:      150,
-			MaxShields:   150,
-			MaxFuel:      1200,
-			Acceleration: 150,
-			TurnRate:     2.5,
-			MaxSpeed:     250,
-			WeaponSlots:  3,
-			MaxArmies:    5,
+			MaxHull:        150,
+			MaxShields:     150,
+			MaxFuel:        1200,
+			Acceleration:   150,
+			TurnRate:       2.5,
+			MaxSpeed:       250,
+			WeaponSlots:    3,
+			MaxArmies:      5,
+			WarpMultiplier: 1.8,
+			WarpFuelRate:   45,
 		}
 	case Cruiser:
 		return ShipStats{
-			MaxHull:      200,
-			MaxShields:   200,
-			MaxFuel:      1400,
-			Acceleration: 120,
-			TurnRate:     2.0,
-			MaxSpeed:     220,
-			WeaponSlots:  4,
-			MaxArmies:    8,
+			MaxHull:        200,
+			MaxShields:     200,
+			MaxFuel:        1400,
+			Acceleration:   120,
+			TurnRate:       2.0,
+			MaxSpeed:       220,
+			WeaponSlots:    4,
+			MaxArmies:      8,
+			WarpMultiplier: 1.6,
+			WarpFuelRate:   50,
 		}
 	case Battleship:
 		return ShipStats{
-			MaxHull:      300,
-			MaxShields:   250,
-			MaxFuel:      1600,
-			Acceleration: 80,
-			TurnRate:     1.5,
-			MaxSpeed:     180,
-			WeaponSlots:  5,
-			MaxArmies:    12,
+			MaxHull:        300,
+			MaxShields:     250,
+			MaxFuel:        1600,
+			Acceleration:   80,
+			TurnRate:       1.5,
+			MaxSpeed:       180,
+			WeaponSlots:    5,
+			MaxArmies:      12,
+			WarpMultiplier: 1.4,
+			WarpFuelRate:   60,
 		}
 	case Assault:
 		return ShipStats{
-			MaxHull:      180,
-			MaxShields:   120,
-			MaxFuel:      1300,
-			Acceleration: 140,
-			TurnRate:     2.2,
-			MaxSpeed:     240,
-			WeaponSlots:  3,
-			MaxArmies:    15,
+			MaxHull:        180,
+			MaxShields:     120,
+			MaxFuel:        1300,
+			Acceleration:   140,
+			TurnRate:       2.2,
+			MaxSpeed:       240,
+			WeaponSlots:    3,
+			MaxArmies:      15,
+			WarpMultiplier: 1.5,
+			WarpFuelRate:   50,
+		}
+	case Starbase:
+		return ShipStats{
+			MaxHull:        600,
+			MaxShields:     500,
+			MaxFuel:        5000,
+			Acceleration:   30,
+			TurnRate:       0.8,
+			MaxSpeed:       60,
+			WeaponSlots:    5,
+			MaxArmies:      25,
+			WarpMultiplier: 0,
+			WarpFuelRate:   0,
 		}
 	default:
 		// Fallback to Scout stats for unknown classes
 		return ShipStats{
-			MaxHull:      100,
-			MaxShields:   100,
-			MaxFuel:      1000,
-			Acceleration: 200,
-			TurnRate:     3.0,
-			MaxSpeed:     300,
-			WeaponSlots:  2,
-			MaxArmies:    2,
+			MaxHull:        100,
+			MaxShields:     100,
+			MaxFuel:        1000,
+			Acceleration:   200,
+			TurnRate:       3.0,
+			MaxSpeed:       300,
+			WeaponSlots:    2,
+			MaxArmies:      2,
+			WarpMultiplier: 2.0,
+			WarpFuelRate:   40,
 		}
 	}
 }
@@ -375,6 +758,8 @@ func (c ShipClass) String() string {
 		return "Battleship"
 	case Assault:
 		return "Assault"
+	case Starbase:
+		return "Starbase"
 	default:
 		return "Unknown"
 	}
@@ -393,6 +778,8 @@ func ShipClassFromString(s string) ShipClass {
 		return Battleship
 	case "Assault":
 		return Assault
+	case "Starbase":
+		return Starbase
 	default:
 		return Scout // fallback to Scout if unknown
 	}
@@ -2,7 +2,6 @@ package entity
 
 import (
 	"testing"
-	"time"
 
 	"github.com/opd-ai/go-netrek/pkg/physics"
 )
@@ -19,6 +18,7 @@ func TestShipClass_String(t *testing.T) {
 		{"Cruiser", Cruiser, "Cruiser"},
 		{"Battleship", Battleship, "Battleship"},
 		{"Assault", Assault, "Assault"},
+		{"Starbase", Starbase, "Starbase"},
 		{"Unknown", ShipClass(999), "Unknown"},
 	}
 
@@ -44,6 +44,7 @@ func TestShipClassFromString(t *testing.T) {
 		{"Cruiser", "Cruiser", Cruiser},
 		{"Battleship", "Battleship", Battleship},
 		{"Assault", "Assault", Assault},
+		{"Starbase", "Starbase", Starbase},
 		{"Unknown", "Unknown", Scout},
 		{"EmptyString", "", Scout},
 		{"InvalidClass", "InvalidClass", Scout},
@@ -103,9 +104,9 @@ func TestNewShip(t *testing.T) {
 		t.Error("Expected ship to have weapons")
 	}
 
-	// Test that LastFired map is initialized
-	if ship.LastFired == nil {
-		t.Error("Expected LastFired map to be initialized")
+	// Test that Cooldowns map is initialized
+	if ship.Cooldowns == nil {
+		t.Error("Expected Cooldowns map to be initialized")
 	}
 }
 
@@ -113,7 +114,7 @@ func TestNewShip(t *testing.T) {
 func TestNewShip_DifferentShipClasses(t *testing.T) {
 	position := physics.Vector2D{X: 0, Y: 0}
 
-	classes := []ShipClass{Scout, Destroyer, Cruiser, Battleship, Assault}
+	classes := []ShipClass{Scout, Destroyer, Cruiser, Battleship, Assault, Starbase}
 
 	for _, class := range classes {
 		t.Run(class.String(), func(t *testing.T) {
@@ -272,6 +273,9 @@ func TestShip_Update(t *testing.T) {
 	})
 
 	t.Run("ShieldRegeneration", func(t *testing.T) {
+		// Raised shields burn fuel, so refuel after the empty-tank case above
+		ship.Fuel = initialFuel
+		ship.SetShields(true)
 		ship.Shields = ship.Stats.MaxShields - 10
 		initialShields := ship.Shields
 		deltaTime := 1.0 // Use 1 second for meaningful regeneration
@@ -315,7 +319,7 @@ func TestShip_FireWeapon(t *testing.T) {
 
 		// Check that cooldown was set
 		weaponName := ship.Weapons[0].GetName()
-		if _, exists := ship.LastFired[weaponName]; !exists {
+		if ship.Cooldowns[weaponName] <= 0 {
 			t.Error("Expected weapon cooldown to be set")
 		}
 	})
@@ -352,7 +356,7 @@ func TestShip_FireWeapon(t *testing.T) {
 
 		// Reset fuel and cooldowns for this test
 		ship.Fuel = 1000
-		ship.LastFired = make(map[string]time.Time)
+		ship.Cooldowns = make(map[string]float64)
 
 		// Debug information
 		t.Logf("Ship fuel: %d", ship.Fuel)
@@ -372,10 +376,344 @@ func TestShip_FireWeapon(t *testing.T) {
 		if projectile2 != nil {
 			t.Error("Expected second shot to fail due to cooldown")
 		}
+
+		// Ticking past the cooldown makes the weapon available again
+		cooldownTicks := int(ship.Weapons[0].GetCooldown().Seconds()*60) + 1
+		for i := 0; i < cooldownTicks; i++ {
+			ship.updateCooldowns(1.0 / 60.0)
+		}
+		if projectile3 := ship.FireWeapon(0); projectile3 == nil {
+			t.Error("Expected shot to succeed once cooldown ticked down")
+		}
+	})
+}
+
+func TestShip_Shields(t *testing.T) {
+	t.Run("LoweredShieldsLetDamageThrough", func(t *testing.T) {
+		ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+		ship.SetShields(false)
+		shields, hull := ship.Shields, ship.Hull
+
+		ship.TakeDamage(10)
+
+		if ship.Shields != shields || ship.Hull != hull-10 {
+			t.Errorf("Expected damage to bypass lowered shields, shields=%d hull=%d", ship.Shields, ship.Hull)
+		}
+	})
+
+	t.Run("RaisedShieldsBurnFuelAndRecharge", func(t *testing.T) {
+		ship := NewShip(ID(2), Scout, 0, physics.Vector2D{})
+		ship.Shields = 0
+		fuel := ship.Fuel
+
+		for i := 0; i < 60; i++ {
+			ship.updateShields(1.0 / 60.0)
+		}
+
+		if used := fuel - ship.Fuel; used < int(shieldFuelPerSecond)-1 || used > int(shieldFuelPerSecond) {
+			t.Errorf("Expected about %v fuel used in one second, got %d", shieldFuelPerSecond, used)
+		}
+		if ship.Shields < int(shieldRegenPerSecond)-1 {
+			t.Errorf("Expected shields to recharge at 60 ticks per second, got %d", ship.Shields)
+		}
+
+		ship.SetShields(false)
+		fuel, shields := ship.Fuel, ship.Shields
+		ship.updateShields(1)
+		if ship.Fuel != fuel || ship.Shields != shields {
+			t.Error("Expected lowered shields to neither burn fuel nor recharge")
+		}
 	})
+
+	t.Run("DropWhenOutOfFuel", func(t *testing.T) {
+		ship := NewShip(ID(3), Scout, 0, physics.Vector2D{})
+		ship.Fuel = 1
+		ship.updateShields(1)
+
+		if ship.ShieldsUp {
+			t.Error("Expected shields to drop with an empty tank")
+		}
+		ship.SetShields(true)
+		if ship.ShieldsUp {
+			t.Error("Expected shields to stay down without fuel")
+		}
+	})
+}
+
+func TestShip_WeaponTemp(t *testing.T) {
+	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	ship.Fuel = 10000
+
+	// Fire torpedoes as fast as the cooldown allows until the weapons lock out
+	fired := 0
+	for i := 0; i < 60*30 && !ship.WeaponsOverheated; i++ {
+		if ship.FireWeapon(0) != nil {
+			fired++
+		}
+		ship.Update(1.0 / 60.0)
+	}
+	if !ship.WeaponsOverheated || fired < 2 {
+		t.Fatalf("Expected sustained fire to overheat the weapons, fired %d", fired)
+	}
+
+	ship.Cooldowns["Torpedo"] = 0
+	if ship.FireWeapon(0) != nil {
+		t.Error("Expected overheated weapons to refuse to fire")
+	}
+
+	for i := 0; i < 60*6; i++ {
+		ship.updateWeaponTemp(1.0 / 60.0)
+	}
+	if ship.WeaponsOverheated || ship.FireWeapon(0) == nil {
+		t.Error("Expected weapons to fire again once cooled")
+	}
+}
+
+func TestShip_EngineTemp(t *testing.T) {
+	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	ship.Thrusting = true
+
+	ship.updateWarp(1)
+	if ship.EngineTemp != thrustHeatPerSecond {
+		t.Errorf("Expected thrust to heat the engine to %v, got %v", thrustHeatPerSecond, ship.EngineTemp)
+	}
+
+	ship.EngineTemp = maxEngineTemp - 1
+	ship.updateWarp(1)
+	if !ship.EngineOverheated {
+		t.Fatal("Expected the engine to overheat")
+	}
+
+	speed := ship.Velocity.Length()
+	ship.updateAcceleration(1)
+	if ship.Velocity.Length() != speed {
+		t.Error("Expected an overheated engine to give no thrust")
+	}
+
+	ship.updateWarp(1)
+	if ship.EngineTemp >= maxEngineTemp {
+		t.Error("Expected an overheated engine to cool even with the throttle open")
+	}
 }
 
 // TestShip_RepairTick tests the RepairTick method
+func TestShip_Rearm(t *testing.T) {
+	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	ship.Cooldowns["Torpedo"] = 1
+	ship.WeaponTemp = maxWeaponTemp
+	ship.WeaponsOverheated = true
+
+	ship.Rearm()
+
+	if len(ship.Cooldowns) != 0 || ship.WeaponTemp != 0 || ship.WeaponsOverheated {
+		t.Error("Expected rearming to clear cooldowns and weapon heat")
+	}
+	if ship.FireWeapon(0) == nil {
+		t.Error("Expected a rearmed ship to fire immediately")
+	}
+}
+
+func TestShip_Explosion(t *testing.T) {
+	scoutRadius, scoutDamage := NewShip(ID(1), Scout, 0, physics.Vector2D{}).Explosion()
+	battleshipRadius, battleshipDamage := NewShip(ID(2), Battleship, 0, physics.Vector2D{}).Explosion()
+	if battleshipRadius <= scoutRadius || battleshipDamage <= scoutDamage {
+		t.Error("Expected a bigger ship to explode with a bigger blast")
+	}
+
+	base := NewShip(ID(3), Starbase, 0, physics.Vector2D{})
+	baseRadius, _ := base.Explosion()
+	if want := (explosionBaseRadius + 600*explosionRadiusPerHull) * starbaseExplosionBonus; baseRadius != want {
+		t.Errorf("Expected starbase blast radius %.1f, got %.1f", want, baseRadius)
+	}
+
+	loaded := NewShip(ID(4), Battleship, 0, physics.Vector2D{})
+	loaded.Armies = loaded.Stats.MaxArmies
+	loadedRadius, loadedDamage := loaded.Explosion()
+	if loadedRadius != battleshipRadius*(1+armyExplosionBonus) || loadedDamage <= battleshipDamage {
+		t.Errorf("Expected a full army load to enlarge the blast, got radius %.1f", loadedRadius)
+	}
+}
+
+func TestShip_Tractor(t *testing.T) {
+	t.Run("DrainsFuelWhileHeld", func(t *testing.T) {
+		ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+		ship.EngageTractor(ID(9), true)
+		initialFuel := ship.Fuel
+
+		for i := 0; i < 60; i++ {
+			ship.updateTractor(1.0 / 60.0)
+		}
+
+		if used := initialFuel - ship.Fuel; used < int(tractorFuelPerSecond)-1 || used > int(tractorFuelPerSecond) {
+			t.Errorf("Expected about %v fuel used in one second, got %d", tractorFuelPerSecond, used)
+		}
+		if ship.Tractor != ID(9) || !ship.Pressor {
+			t.Error("Expected the pressor beam to hold while the ship has fuel")
+		}
+	})
+
+	t.Run("ReleasedWhenCloakedOrEmpty", func(t *testing.T) {
+		ship := NewShip(ID(2), Scout, 0, physics.Vector2D{})
+		ship.EngageTractor(ID(9), false)
+		ship.SetCloak(true)
+		ship.updateTractor(1.0 / 60.0)
+		if ship.Tractor != 0 {
+			t.Error("Expected cloaking to drop the beam")
+		}
+
+		ship.SetCloak(false)
+		ship.Fuel = 0
+		ship.EngageTractor(ID(9), false)
+		if ship.Tractor != 0 {
+			t.Error("Expected no beam without fuel")
+		}
+	})
+}
+
+func TestShip_Cloak(t *testing.T) {
+	t.Run("DrainsFuelPerSecond", func(t *testing.T) {
+		ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+		ship.SetCloak(true)
+		initialFuel := ship.Fuel
+
+		for i := 0; i < 60; i++ {
+			ship.updateCloak(1.0 / 60.0)
+		}
+
+		if used := initialFuel - ship.Fuel; used < int(cloakFuelPerSecond)-1 || used > int(cloakFuelPerSecond) {
+			t.Errorf("Expected about %v fuel used in one second, got %d", cloakFuelPerSecond, used)
+		}
+		if !ship.Cloaked {
+			t.Error("Expected ship to stay cloaked while it has fuel")
+		}
+	})
+
+	t.Run("CannotFireWhileCloaked", func(t *testing.T) {
+		ship := NewShip(ID(2), Scout, 0, physics.Vector2D{})
+		ship.SetCloak(true)
+
+		if projectile := ship.FireWeapon(0); projectile != nil {
+			t.Error("Expected no projectile while cloaked")
+		}
+
+		ship.SetCloak(false)
+		if projectile := ship.FireWeapon(0); projectile == nil {
+			t.Error("Expected projectile after decloaking")
+		}
+	})
+
+	t.Run("DecloaksWhenOutOfFuel", func(t *testing.T) {
+		ship := NewShip(ID(3), Scout, 0, physics.Vector2D{})
+		ship.SetCloak(true)
+		ship.Fuel = 1
+
+		for i := 0; i < 10; i++ {
+			ship.updateCloak(1.0 / 60.0)
+		}
+
+		if ship.Cloaked || ship.Fuel != 0 {
+			t.Errorf("Expected cloak to drop with an empty tank, cloaked=%v fuel=%d", ship.Cloaked, ship.Fuel)
+		}
+
+		ship.SetCloak(true)
+		if ship.Cloaked {
+			t.Error("Expected cloak to refuse to engage without fuel")
+		}
+	})
+}
+
+func TestShip_Warp(t *testing.T) {
+	const tick = 1.0 / 60.0
+
+	t.Run("RaisesTopSpeedAndBurnsFuel", func(t *testing.T) {
+		ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+		ship.Thrusting = true
+		ship.SetWarp(true)
+		initialFuel := ship.Fuel
+
+		for i := 0; i < 300; i++ {
+			ship.Update(tick)
+		}
+
+		if speed := ship.Velocity.Length(); speed <= ship.Stats.MaxSpeed {
+			t.Errorf("Expected warp speed above %v, got %v", ship.Stats.MaxSpeed, speed)
+		}
+		// 5 seconds of thrust (1 fuel per tick) plus warp burn
+		minBurn := 300 + int(ship.Stats.WarpFuelRate*5) - 1
+		if used := initialFuel - ship.Fuel; used < minBurn {
+			t.Errorf("Expected at least %d fuel used, got %d", minBurn, used)
+		}
+		if ship.EngineTemp <= 0 {
+			t.Error("Expected warping to heat the engine")
+		}
+	})
+
+	t.Run("OverheatsAndRecovers", func(t *testing.T) {
+		ship := NewShip(ID(2), Scout, 0, physics.Vector2D{})
+		ship.SetWarp(true)
+
+		for i := 0; i < 60*11 && ship.Warping; i++ {
+			ship.updateWarp(tick)
+		}
+		if ship.Warping || !ship.EngineOverheated {
+			t.Fatal("Expected warp to drop out when the engine overheats")
+		}
+
+		ship.SetWarp(true)
+		if ship.Warping {
+			t.Error("Expected overheated engine to refuse warp")
+		}
+
+		for i := 0; i < 60*11; i++ {
+			ship.updateWarp(tick)
+		}
+		ship.SetWarp(true)
+		if !ship.Warping {
+			t.Error("Expected warp to be available once the engine cooled")
+		}
+	})
+
+	t.Run("ShieldsOfflineWhileWarping", func(t *testing.T) {
+		ship := NewShip(ID(3), Scout, 0, physics.Vector2D{})
+		ship.SetWarp(true)
+		shields, hull := ship.Shields, ship.Hull
+
+		ship.TakeDamage(10)
+
+		if ship.Shields != shields || ship.Hull != hull-10 {
+			t.Errorf("Expected damage to bypass shields, shields=%d hull=%d", ship.Shields, ship.Hull)
+		}
+	})
+
+	t.Run("RaisedShieldsBurnNoFuelWhileWarping", func(t *testing.T) {
+		shielded := NewShip(ID(5), Scout, 0, physics.Vector2D{})
+		unshielded := NewShip(ID(6), Scout, 0, physics.Vector2D{})
+		unshielded.SetShields(false)
+		shielded.SetWarp(true)
+		unshielded.SetWarp(true)
+
+		for i := 0; i < 60; i++ {
+			shielded.Update(tick)
+			unshielded.Update(tick)
+		}
+
+		if !shielded.ShieldsUp || shielded.Fuel != unshielded.Fuel {
+			t.Errorf("Expected raised shields to burn nothing while warping, fuel %d vs %d",
+				shielded.Fuel, unshielded.Fuel)
+		}
+	})
+
+	t.Run("RequiresWarpDrive", func(t *testing.T) {
+		ship := NewShip(ID(4), Scout, 0, physics.Vector2D{})
+		ship.Stats.WarpMultiplier = 0
+		ship.SetWarp(true)
+
+		if ship.Warping {
+			t.Error("Expected ship without a warp drive to stay at sublight")
+		}
+	})
+}
+
 func TestShip_RepairTick(t *testing.T) {
 	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{X: 0, Y: 0})
 	deltaTime := 1.0 // 1 second
@@ -528,9 +866,10 @@ func TestShipClassDifferentiation(t *testing.T) {
 	cruiser := NewShip(3, Cruiser, 1, position)
 	battleship := NewShip(4, Battleship, 1, position)
 	assault := NewShip(5, Assault, 1, position)
+	starbase := NewShip(6, Starbase, 1, position)
 
-	ships := []*Ship{scout, destroyer, cruiser, battleship, assault}
-	names := []string{"Scout", "Destroyer", "Cruiser", "Battleship", "Assault"}
+	ships := []*Ship{scout, destroyer, cruiser, battleship, assault, starbase}
+	names := []string{"Scout", "Destroyer", "Cruiser", "Battleship", "Assault", "Starbase"}
 
 	// Verify all ship classes have different stats (no two should be identical)
 	for i := 0; i < len(ships); i++ {
@@ -604,3 +943,31 @@ func BenchmarkShip_TakeDamage(b *testing.B) {
 		}
 	}
 }
+
+func TestShip_RepairAndRefuelAccumulate(t *testing.T) {
+	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	ship.Hull = ship.Stats.MaxHull / 2
+	ship.Fuel = ship.Stats.MaxFuel / 2
+	hull, fuel := ship.Hull, ship.Fuel
+
+	// At 60 ticks per second each tick is worth less than one point
+	ship.RepairMode = true
+	for i := 0; i < 60; i++ {
+		ship.RepairTick(1.0 / 60)
+		ship.Refuel(50, 1.0/60)
+	}
+
+	// Allow one point of floating point rounding across the ticks
+	if want := hull + ship.Stats.MaxHull/10; ship.Hull < want-1 || ship.Hull > want {
+		t.Errorf("expected hull %d after one second of repair, got %d", want, ship.Hull)
+	}
+	if want := fuel + 50 - 5; ship.Fuel < want-1 || ship.Fuel > want+1 {
+		t.Errorf("expected fuel %d after one second of refuel and repair, got %d", want, ship.Fuel)
+	}
+
+	ship.Fuel = ship.Stats.MaxFuel - 1
+	ship.Refuel(50, 1)
+	if ship.Fuel != ship.Stats.MaxFuel {
+		t.Errorf("refuel should stop at MaxFuel %d, got %d", ship.Stats.MaxFuel, ship.Fuel)
+	}
+}
@@ -2,6 +2,7 @@
 package entity
 
 import (
+	"math"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +17,13 @@ type Weapon interface {
 	CreateProjectile(ownerID ID, position physics.Vector2D, angle float64, teamID int) *Projectile
 }
 
+// HitscanWeapon is a weapon resolved instantly along a ray rather than by a projectile
+type HitscanWeapon interface {
+	Weapon
+	GetRange() float64
+	DamageAt(distance float64) int
+}
+
 // BaseWeapon contains common functionality for all weapons
 type BaseWeapon struct {
 	Name     string
@@ -41,6 +49,127 @@ func (w *BaseWeapon) GetFuelCost() int {
 	return w.FuelCost
 }
 
+// Weapon behaviours, which decide how a weapon type fires and what its shots do
+const (
+	TorpedoBehavior = "torpedo" // Straight-flying projectile that can be detonated
+	PhaserBehavior  = "phaser"  // Hitscan beam whose damage falls off with distance
+	PlasmaBehavior  = "plasma"  // Slow homing projectile that explodes with area damage
+)
+
+// WeaponStats contains the statistics for a weapon type
+type WeaponStats struct {
+	Behavior    string // One of TorpedoBehavior, PhaserBehavior or PlasmaBehavior
+	Damage      int
+	Speed       float64
+	Range       float64
+	Cooldown    time.Duration
+	FuelCost    int
+	TurnRate    float64 // Plasma only: radians per second the torpedo can steer
+	SeekAngle   float64 // Plasma only: half-angle of the cone in which it picks targets
+	BlastRadius float64 // Plasma only: radius of the explosion
+}
+
+var weaponTypeStats map[string]WeaponStats
+
+// SetWeaponTypeStats allows the config loader to inject custom weapon types, keyed by weapon name
+func SetWeaponTypeStats(stats map[string]WeaponStats) {
+	weaponTypeStats = stats
+}
+
+var shipLoadouts map[string][]string
+
+// SetShipLoadouts allows the config loader to inject the weapons each ship class
+// mounts, keyed by class name and listed in slot order
+func SetShipLoadouts(loadouts map[string][]string) {
+	shipLoadouts = loadouts
+}
+
+// getWeaponStats returns the statistics for a named weapon type, using config if available
+func getWeaponStats(name string) (WeaponStats, bool) {
+	if s, ok := weaponTypeStats[name]; ok {
+		return s, true
+	}
+	switch name {
+	case "Torpedo":
+		return WeaponStats{
+			Behavior: TorpedoBehavior,
+			Damage:   40,
+			Speed:    500,
+			Range:    2000,
+			Cooldown: 500 * time.Millisecond,
+			FuelCost: 10,
+		}, true
+	case "Phaser":
+		return WeaponStats{
+			Behavior: PhaserBehavior,
+			Damage:   20,
+			Speed:    1000,
+			Range:    800,
+			Cooldown: 200 * time.Millisecond,
+			FuelCost: 5,
+		}, true
+	case "Plasma":
+		return WeaponStats{
+			Behavior:    PlasmaBehavior,
+			Damage:      100,
+			Speed:       300,
+			Range:       3000,
+			Cooldown:    3 * time.Second,
+			FuelCost:    30,
+			TurnRate:    1.5,
+			SeekAngle:   math.Pi / 4,
+			BlastRadius: 100,
+		}, true
+	default:
+		return WeaponStats{}, false
+	}
+}
+
+// getShipLoadout returns the weapon names a ship class mounts, using config if available.
+// Without a configured loadout every ship carries torpedoes and phasers, plus plasma
+// if it has a third weapon slot.
+func getShipLoadout(class ShipClass, stats ShipStats) []string {
+	if loadout, ok := shipLoadouts[class.String()]; ok {
+		return loadout
+	}
+	loadout := []string{"Torpedo", "Phaser"}
+	if stats.WeaponSlots >= 3 {
+		loadout = append(loadout, "Plasma")
+	}
+	return loadout
+}
+
+// NewWeapon creates the named weapon type for a ship, or returns nil if the type is
+// unknown or has an unknown behaviour
+func NewWeapon(name string, ownerID ID) Weapon {
+	stats, ok := getWeaponStats(name)
+	if !ok {
+		return nil
+	}
+	switch stats.Behavior {
+	case TorpedoBehavior:
+		return newTorpedo(name, stats, ownerID)
+	case PhaserBehavior:
+		return newPhaser(name, stats, ownerID)
+	case PlasmaBehavior:
+		return newPlasma(name, stats, ownerID)
+	default:
+		return nil
+	}
+}
+
+// newBaseWeapon creates the common part of a weapon from its stats
+func newBaseWeapon(name string, stats WeaponStats, ownerID ID) BaseWeapon {
+	return BaseWeapon{
+		Name:     name,
+		Cooldown: stats.Cooldown,
+		FuelCost: stats.FuelCost,
+		Damage:   stats.Damage,
+		Speed:    stats.Speed,
+		OwnerID:  ownerID,
+	}
+}
+
 // Torpedo weapon implementation
 type Torpedo struct {
 	BaseWeapon
@@ -49,16 +178,15 @@ type Torpedo struct {
 
 // NewTorpedo creates a new torpedo weapon
 func NewTorpedo(ownerID ID) *Torpedo {
+	stats, _ := getWeaponStats("Torpedo")
+	return newTorpedo("Torpedo", stats, ownerID)
+}
+
+// newTorpedo creates a torpedo weapon from a weapon type's stats
+func newTorpedo(name string, stats WeaponStats, ownerID ID) *Torpedo {
 	return &Torpedo{
-		BaseWeapon: BaseWeapon{
-			Name:     "Torpedo",
-			Cooldown: 500 * time.Millisecond,
-			FuelCost: 10,
-			Damage:   40,
-			Speed:    500,
-			OwnerID:  ownerID,
-		},
-		Range: 2000,
+		BaseWeapon: newBaseWeapon(name, stats, ownerID),
+		Range:      stats.Range,
 	}
 }
 
@@ -93,17 +221,33 @@ type Phaser struct {
 
 // NewPhaser creates a new phaser weapon
 func NewPhaser(ownerID ID) *Phaser {
+	stats, _ := getWeaponStats("Phaser")
+	return newPhaser("Phaser", stats, ownerID)
+}
+
+// newPhaser creates a phaser weapon from a weapon type's stats
+func newPhaser(name string, stats WeaponStats, ownerID ID) *Phaser {
 	return &Phaser{
-		BaseWeapon: BaseWeapon{
-			Name:     "Phaser",
-			Cooldown: 200 * time.Millisecond,
-			FuelCost: 5,
-			Damage:   20,
-			Speed:    1000,
-			OwnerID:  ownerID,
-		},
-		Range: 800,
+		BaseWeapon: newBaseWeapon(name, stats, ownerID),
+		Range:      stats.Range,
+	}
+}
+
+// phaserFalloff is the fraction of a phaser's damage lost by the end of its range
+const phaserFalloff = 0.75
+
+// GetRange returns the phaser's maximum range
+func (p *Phaser) GetRange() float64 {
+	return p.Range
+}
+
+// DamageAt returns the damage a phaser deals to a target at the given distance,
+// falling off linearly to a quarter at the end of its range
+func (p *Phaser) DamageAt(distance float64) int {
+	if distance >= p.Range {
+		return 0
 	}
+	return int(math.Round(float64(p.Damage) * (1 - phaserFalloff*distance/p.Range)))
 }
 
 // CreateProjectile creates a phaser projectile
@@ -129,6 +273,59 @@ func (p *Phaser) CreateProjectile(ownerID ID, position physics.Vector2D, angle f
 	}
 }
 
+// Plasma weapon implementation. Plasma torpedoes are slow and expensive, but
+// home in on enemies ahead of them and explode with area damage.
+type Plasma struct {
+	BaseWeapon
+	Range       float64
+	TurnRate    float64 // Radians per second the torpedo can steer towards a target
+	SeekAngle   float64 // Half-angle of the cone ahead of the torpedo in which it picks targets
+	BlastRadius float64
+}
+
+// NewPlasma creates a new plasma torpedo weapon
+func NewPlasma(ownerID ID) *Plasma {
+	stats, _ := getWeaponStats("Plasma")
+	return newPlasma("Plasma", stats, ownerID)
+}
+
+// newPlasma creates a plasma torpedo weapon from a weapon type's stats
+func newPlasma(name string, stats WeaponStats, ownerID ID) *Plasma {
+	return &Plasma{
+		BaseWeapon:  newBaseWeapon(name, stats, ownerID),
+		Range:       stats.Range,
+		TurnRate:    stats.TurnRate,
+		SeekAngle:   stats.SeekAngle,
+		BlastRadius: stats.BlastRadius,
+	}
+}
+
+// CreateProjectile creates a plasma projectile
+func (p *Plasma) CreateProjectile(ownerID ID, position physics.Vector2D, angle float64, teamID int) *Projectile {
+	return &Projectile{
+		BaseEntity: BaseEntity{
+			ID:       GenerateID(),
+			Position: position,
+			Velocity: physics.FromAngle(angle, p.Speed),
+			Rotation: angle,
+			Collider: physics.Circle{
+				Center: position,
+				Radius: 8,
+			},
+			Active: true,
+		},
+		Type:             "Plasma",
+		OwnerID:          ownerID,
+		TeamID:           teamID,
+		Damage:           p.Damage,
+		Range:            p.Range,
+		DistanceTraveled: 0,
+		TurnRate:         p.TurnRate,
+		SeekAngle:        p.SeekAngle,
+		BlastRadius:      p.BlastRadius,
+	}
+}
+
 // Projectile represents a weapon projectile in the game
 type Projectile struct {
 	BaseEntity
@@ -138,6 +335,41 @@ type Projectile struct {
 	Damage           int
 	Range            float64
 	DistanceTraveled float64
+
+	// TurnRate is how fast a homing projectile steers, in radians per second; 0 flies straight
+	TurnRate float64
+	// SeekAngle is the half-angle of the cone ahead of a homing projectile in which it picks targets
+	SeekAngle float64
+	// BlastRadius is the radius of the area damage dealt when the projectile explodes;
+	// 0 means it only damages what it hits
+	BlastRadius float64
+}
+
+// IsHoming reports whether the projectile steers towards targets
+func (p *Projectile) IsHoming() bool {
+	return p.TurnRate > 0
+}
+
+// InSeekCone reports whether a point lies within the cone ahead of the projectile
+// in which it picks targets
+func (p *Projectile) InSeekCone(point physics.Vector2D) bool {
+	return math.Abs(angleBetween(p.Rotation, point.Sub(p.Position).Angle())) <= p.SeekAngle
+}
+
+// SteerTowards turns the projectile towards a point by at most TurnRate radians per second,
+// keeping its speed
+func (p *Projectile) SteerTowards(target physics.Vector2D, deltaTime float64) {
+	maxTurn := p.TurnRate * deltaTime
+	turn := angleBetween(p.Rotation, target.Sub(p.Position).Angle())
+	turn = math.Max(-maxTurn, math.Min(maxTurn, turn))
+
+	p.Rotation += turn
+	p.Velocity = physics.FromAngle(p.Rotation, p.Velocity.Length())
+}
+
+// angleBetween returns the signed smallest rotation from one angle to another, in [-π, π]
+func angleBetween(from, to float64) float64 {
+	return math.Remainder(to-from, 2*math.Pi)
 }
 
 // Update updates the projectile's position and checks if it has exceeded its range
@@ -203,6 +203,57 @@ func TestNewPhaser(t *testing.T) {
 	}
 }
 
+func TestNewWeapon(t *testing.T) {
+	SetWeaponTypeStats(map[string]WeaponStats{
+		"Disruptor": {Behavior: PhaserBehavior, Damage: 55, Range: 400, Cooldown: time.Second, FuelCost: 8},
+		"Broken":    {Behavior: "laser"},
+	})
+	defer SetWeaponTypeStats(nil)
+
+	weapon, ok := NewWeapon("Disruptor", ID(1)).(*Phaser)
+	if !ok {
+		t.Fatal("Expected a configured phaser-behaviour weapon to be a Phaser")
+	}
+	if weapon.GetName() != "Disruptor" || weapon.Damage != 55 || weapon.Range != 400 ||
+		weapon.GetCooldown() != time.Second || weapon.GetFuelCost() != 8 {
+		t.Errorf("Expected configured stats, got %+v", weapon)
+	}
+
+	if _, ok := NewWeapon("Torpedo", ID(1)).(*Torpedo); !ok {
+		t.Error("Expected built-in torpedoes to be available when not configured")
+	}
+	if NewWeapon("Broken", ID(1)) != nil || NewWeapon("Missing", ID(1)) != nil {
+		t.Error("Expected no weapon for an unknown behaviour or name")
+	}
+}
+
+func TestNewShip_Loadout(t *testing.T) {
+	SetShipLoadouts(map[string][]string{
+		"Scout":   {"Plasma", "Missing", "Torpedo", "Phaser"},
+		"Cruiser": {"Phaser"},
+	})
+	defer SetShipLoadouts(nil)
+
+	scout := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	if len(scout.Weapons) != scout.Stats.WeaponSlots {
+		t.Fatalf("Expected the loadout to fill %d slots, got %d weapons", scout.Stats.WeaponSlots, len(scout.Weapons))
+	}
+	if scout.Weapons[0].GetName() != "Plasma" || scout.Weapons[1].GetName() != "Torpedo" {
+		t.Errorf("Expected Plasma then Torpedo, skipping the unknown weapon; got %s, %s",
+			scout.Weapons[0].GetName(), scout.Weapons[1].GetName())
+	}
+
+	cruiser := NewShip(ID(2), Cruiser, 0, physics.Vector2D{})
+	if len(cruiser.Weapons) != 1 || cruiser.Weapons[0].GetName() != "Phaser" {
+		t.Errorf("Expected only the configured phaser, got %d weapons", len(cruiser.Weapons))
+	}
+
+	destroyer := NewShip(ID(3), Destroyer, 0, physics.Vector2D{})
+	if len(destroyer.Weapons) != 3 {
+		t.Errorf("Expected the default loadout without a configured one, got %d weapons", len(destroyer.Weapons))
+	}
+}
+
 func TestTorpedo_CreateProjectile(t *testing.T) {
 	ownerID := ID(42)
 	torpedo := NewTorpedo(ownerID)
@@ -313,6 +364,47 @@ func TestPhaser_CreateProjectile(t *testing.T) {
 	}
 }
 
+func TestPlasma_CreateProjectile(t *testing.T) {
+	plasma := NewPlasma(ID(7))
+	projectile := plasma.CreateProjectile(ID(7), physics.Vector2D{}, 0, 1)
+
+	if projectile.Type != "Plasma" {
+		t.Errorf("Projectile type = %q, want %q", projectile.Type, "Plasma")
+	}
+	if !projectile.IsHoming() {
+		t.Error("plasma projectile should home")
+	}
+	if projectile.BlastRadius != plasma.BlastRadius || projectile.SeekAngle != plasma.SeekAngle {
+		t.Errorf("projectile blast %.0f seek %.2f, want %.0f and %.2f",
+			projectile.BlastRadius, projectile.SeekAngle, plasma.BlastRadius, plasma.SeekAngle)
+	}
+	if NewTorpedo(ID(7)).CreateProjectile(ID(7), physics.Vector2D{}, 0, 1).IsHoming() {
+		t.Error("torpedo projectile should fly straight")
+	}
+}
+
+func TestProjectile_SteerTowards(t *testing.T) {
+	projectile := NewPlasma(ID(1)).CreateProjectile(ID(1), physics.Vector2D{}, 0, 0)
+	speed := projectile.Velocity.Length()
+
+	// Target straight above: turn left by at most TurnRate per second
+	target := physics.Vector2D{X: 0, Y: 500}
+	if !projectile.InSeekCone(physics.Vector2D{X: 500, Y: 100}) || projectile.InSeekCone(target) {
+		t.Fatal("seek cone should contain targets ahead but not to the side")
+	}
+	projectile.SteerTowards(target, 0.1)
+
+	if want := projectile.TurnRate * 0.1; math.Abs(projectile.Rotation-want) > 1e-9 {
+		t.Errorf("rotation after steering = %.3f, want %.3f", projectile.Rotation, want)
+	}
+	if math.Abs(projectile.Velocity.Length()-speed) > 1e-9 {
+		t.Errorf("steering changed speed from %.1f to %.1f", speed, projectile.Velocity.Length())
+	}
+	if projectile.Velocity.Y <= 0 {
+		t.Errorf("velocity should bend towards the target, got %v", projectile.Velocity)
+	}
+}
+
 func TestProjectile_Update(t *testing.T) {
 	// Create a projectile for testing
 	projectile := &Projectile{
@@ -470,3 +562,43 @@ func TestWeapon_Interface(t *testing.T) {
 		t.Error("Phaser CreateProjectile() should return non-nil projectile")
 	}
 }
+
+func TestPhaser_DamageAt(t *testing.T) {
+	phaser := NewPhaser(ID(1))
+
+	if got := phaser.DamageAt(0); got != phaser.Damage {
+		t.Errorf("DamageAt(0) = %d, want %d", got, phaser.Damage)
+	}
+	if got := phaser.DamageAt(phaser.Range / 2); got >= phaser.Damage || got <= phaser.DamageAt(phaser.Range*0.99) {
+		t.Errorf("damage should fall off with distance, got %d at half range", got)
+	}
+	if got := phaser.DamageAt(phaser.Range); got != 0 {
+		t.Errorf("DamageAt(Range) = %d, want 0", got)
+	}
+}
+
+func TestShip_FireHitscan(t *testing.T) {
+	ship := NewShip(ID(1), Scout, 0, physics.Vector2D{})
+	fuel := ship.Fuel
+
+	if projectile := ship.FireWeapon(1); projectile != nil {
+		t.Error("phaser should not create a projectile")
+	}
+	if ship.Fuel != fuel {
+		t.Error("declined projectile fire should not spend fuel")
+	}
+
+	weapon, ok := ship.FireHitscan(1)
+	if !ok || weapon.GetName() != "Phaser" {
+		t.Fatalf("FireHitscan(1) = %v, %v; want the phaser", weapon, ok)
+	}
+	if ship.Fuel != fuel-weapon.GetFuelCost() || ship.Cooldowns["Phaser"] <= 0 {
+		t.Error("FireHitscan should spend fuel and start the cooldown")
+	}
+	if _, ok := ship.FireHitscan(1); ok {
+		t.Error("phaser should not fire again while cooling down")
+	}
+	if _, ok := ship.FireHitscan(0); ok {
+		t.Error("torpedo is not a hitscan weapon")
+	}
+}
@@ -23,6 +23,17 @@ const (
 	GameStarted      Type = "game_started"
 	GameEnded        Type = "game_ended"
 	TeamScoreChanged Type = "team_score_changed"
+	ShipRespawned    Type = "ship_respawned"
+	RespawnCountdown Type = "respawn_countdown"
+	TeamKill         Type = "team_kill"
+	TeamsUnbalanced  Type = "teams_unbalanced"
+	MatchReset       Type = "match_reset"
+	GamePaused       Type = "game_paused"
+	GameResumed      Type = "game_resumed"
+	FlagPickedUp     Type = "flag_picked_up"
+	FlagDropped      Type = "flag_dropped"
+	FlagReturned     Type = "flag_returned"
+	FlagCaptured     Type = "flag_captured"
 )
 
 // getEventCallerInfo returns the calling function name for event logging
@@ -306,3 +317,91 @@ func NewCollisionEvent(source interface{}, entityA, entityB uint64) *CollisionEv
 		EntityB: entityB,
 	}
 }
+
+// RespawnEvent contains information about a dead player's respawn countdown
+type RespawnEvent struct {
+	BaseEvent
+	PlayerID         uint64
+	TeamID           int
+	SecondsRemaining int
+}
+
+// NewRespawnEvent creates a new respawn countdown event
+func NewRespawnEvent(source interface{}, playerID uint64, teamID, secondsRemaining int) *RespawnEvent {
+	return &RespawnEvent{
+		BaseEvent: BaseEvent{
+			EventType: RespawnCountdown,
+			Source:    source,
+		},
+		PlayerID:         playerID,
+		TeamID:           teamID,
+		SecondsRemaining: secondsRemaining,
+	}
+}
+
+// TeamKillEvent contains information about a ship destroyed by its own team
+type TeamKillEvent struct {
+	BaseEvent
+	KillerID uint64 // Player ID of the shooter, 0 if unknown
+	VictimID uint64 // Player ID of the destroyed ship's pilot, 0 if unknown
+	TeamID   int
+}
+
+// NewTeamKillEvent creates a new team kill event
+func NewTeamKillEvent(source interface{}, killerID, victimID uint64, teamID int) *TeamKillEvent {
+	return &TeamKillEvent{
+		BaseEvent: BaseEvent{
+			EventType: TeamKill,
+			Source:    source,
+		},
+		KillerID: killerID,
+		VictimID: victimID,
+		TeamID:   teamID,
+	}
+}
+
+// TeamBalanceEvent reports teams whose sizes have drifted too far apart
+type TeamBalanceEvent struct {
+	BaseEvent
+	LargerTeamID  int
+	LargerSize    int
+	SmallerTeamID int
+	SmallerSize   int
+}
+
+// NewTeamBalanceEvent creates a new teams unbalanced event
+func NewTeamBalanceEvent(source interface{}, largerTeamID, largerSize, smallerTeamID, smallerSize int) *TeamBalanceEvent {
+	return &TeamBalanceEvent{
+		BaseEvent: BaseEvent{
+			EventType: TeamsUnbalanced,
+			Source:    source,
+		},
+		LargerTeamID:  largerTeamID,
+		LargerSize:    largerSize,
+		SmallerTeamID: smallerTeamID,
+		SmallerSize:   smallerSize,
+	}
+}
+
+// FlagEvent contains information about a capture the flag event
+type FlagEvent struct {
+	BaseEvent
+	FlagID     uint64
+	FlagTeamID int    // Team the flag belongs to
+	ShipID     uint64 // Ship that picked up, dropped, returned or captured the flag, 0 if none
+	ShipTeamID int    // Team of that ship, -1 if none
+}
+
+// NewFlagEvent creates a new flag event
+func NewFlagEvent(eventType Type, source interface{}, flagID uint64, flagTeamID int, shipID uint64, shipTeamID int) *FlagEvent {
+	return &FlagEvent{
+		BaseEvent: BaseEvent{
+			EventType: eventType,
+			Source:    source,
+		},
+		FlagID:     flagID,
+		FlagTeamID: flagTeamID,
+		ShipID:     shipID,
+		ShipTeamID: shipTeamID,
+	}
+}
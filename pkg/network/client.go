@@ -33,6 +33,7 @@ type GameClient struct {
 	conn                 net.Conn
 	clientID             entity.ID
 	playerID             entity.ID
+	teamID               int // Team the server placed the player on
 	serverAddress        string
 	connected            bool
 	receivedStates       chan *engine.GameState
@@ -170,7 +171,47 @@ func (c *GameClient) RequestShipClass(class entity.ShipClass) error {
 	return nil
 }
 
-// Connect connects to the game server
+// RequestRespawn asks the server to respawn the player's ship once the respawn delay has elapsed.
+// Only needed when the server runs in respawn-on-request mode.
+func (c *GameClient) RequestRespawn() error {
+	if !c.connected {
+		return errors.New("not connected")
+	}
+
+	return c.sendMessage(RequestRespawn, struct{}{})
+}
+
+// SetReady marks the player ready, or not, to start the match in the tournament lobby.
+func (c *GameClient) SetReady(ready bool) error {
+	if !c.connected {
+		return errors.New("not connected")
+	}
+
+	return c.sendMessage(SetReady, readyRequest{Ready: ready})
+}
+
+// Pause asks the server to pause the game. It needs the server's admin password, and
+// the game state reports Paused once the server has accepted it.
+func (c *GameClient) Pause(adminPassword string) error {
+	return c.sendAdminCommand(adminPassword, adminPause)
+}
+
+// Resume asks the server to resume a paused game. It needs the server's admin password.
+func (c *GameClient) Resume(adminPassword string) error {
+	return c.sendAdminCommand(adminPassword, adminResume)
+}
+
+// sendAdminCommand sends an admin command with the admin password.
+func (c *GameClient) sendAdminCommand(adminPassword, command string) error {
+	if !c.connected {
+		return errors.New("not connected")
+	}
+
+	return c.sendMessage(AdminCommand, adminRequest{Password: adminPassword, Command: command})
+}
+
+// Connect connects to the game server. Pass engine.AutoTeam as the teamID to have
+// the server place the player on the smallest team; TeamID reports the team joined.
 func (c *GameClient) Connect(address, playerName string, teamID int) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -240,9 +281,11 @@ func (c *GameClient) sendConnectRequest(playerName string, teamID int) error {
 	connectReq := struct {
 		PlayerName string `json:"playerName"`
 		TeamID     int    `json:"teamID"`
+		AutoTeam   bool   `json:"autoTeam,omitempty"`
 	}{
 		PlayerName: playerName,
 		TeamID:     teamID,
+		AutoTeam:   teamID == engine.AutoTeam,
 	}
 
 	if err := c.sendMessage(ConnectRequest, connectReq); err != nil {
@@ -284,6 +327,7 @@ func (c *GameClient) parseAndValidateResponse(data []byte) error {
 		Error    string    `json:"error"`
 		PlayerID entity.ID `json:"playerID"`
 		ClientID entity.ID `json:"clientID"`
+		TeamID   int       `json:"teamID"`
 	}
 
 	if err := json.Unmarshal(data, &connectResp); err != nil {
@@ -298,6 +342,7 @@ func (c *GameClient) parseAndValidateResponse(data []byte) error {
 
 	c.playerID = connectResp.PlayerID
 	c.clientID = connectResp.ClientID
+	c.teamID = connectResp.TeamID
 	c.connected = true
 
 	return nil
@@ -347,20 +392,7 @@ func (c *GameClient) Disconnect() error {
 func (c *GameClient) SendInput(thrust, turnLeft, turnRight bool, fireWeapon int,
 	beamDown, beamUp bool, beamAmount int, targetID entity.ID,
 ) error {
-	if !c.connected {
-		return errors.New("not connected")
-	}
-
-	input := struct {
-		Thrust     bool      `json:"thrust"`
-		TurnLeft   bool      `json:"turnLeft"`
-		TurnRight  bool      `json:"turnRight"`
-		FireWeapon int       `json:"fireWeapon"`
-		BeamDown   bool      `json:"beamDown"`
-		BeamUp     bool      `json:"beamUp"`
-		BeamAmount int       `json:"beamAmount"`
-		TargetID   entity.ID `json:"targetID"`
-	}{
+	return c.SendPlayerInput(PlayerInputData{
 		Thrust:     thrust,
 		TurnLeft:   turnLeft,
 		TurnRight:  turnRight,
@@ -369,6 +401,14 @@ func (c *GameClient) SendInput(thrust, turnLeft, turnRight bool, fireWeapon int,
 		BeamUp:     beamUp,
 		BeamAmount: beamAmount,
 		TargetID:   targetID,
+	})
+}
+
+// SendPlayerInput sends a complete set of player input to the server,
+// including ship systems such as the cloaking device that SendInput leaves off
+func (c *GameClient) SendPlayerInput(input PlayerInputData) error {
+	if !c.connected {
+		return errors.New("not connected")
 	}
 
 	return c.sendMessage(PlayerInput, input)
@@ -396,6 +436,13 @@ func (c *GameClient) GetLatency() time.Duration {
 	return c.latency
 }
 
+// TeamID returns the team the player was placed on when connecting
+func (c *GameClient) TeamID() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.teamID
+}
+
 // GetGameStateChannel returns the channel for receiving game states
 func (c *GameClient) GetGameStateChannel() <-chan *engine.GameState {
 	return c.receivedStates
@@ -428,6 +475,9 @@ func (c *GameClient) messageLoop() {
 		case PingResponse:
 			c.handlePingResponse(data)
 
+		case ShipClassResponse:
+			c.handleShipClassResponse(data)
+
 		default:
 			// Ignore unknown message types
 		}
@@ -478,6 +528,28 @@ func (c *GameClient) handleChatMessage(data []byte) {
 	c.eventBus.Publish(chatEvent)
 }
 
+// handleShipClassResponse publishes the server's verdict on a ship class request
+func (c *GameClient) handleShipClassResponse(data []byte) {
+	var resp shipClassResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return
+	}
+
+	eventType := ShipClassAccepted
+	if !resp.Accepted {
+		eventType = ShipClassRejected
+	}
+
+	c.eventBus.Publish(&ShipClassEvent{
+		BaseEvent: event.BaseEvent{
+			EventType: eventType,
+			Source:    c,
+		},
+		ShipClass: resp.ShipClass,
+		Reason:    resp.Reason,
+	})
+}
+
 // handlePingResponse processes a ping response
 func (c *GameClient) handlePingResponse(data []byte) {
 	var pingTime time.Time
@@ -793,6 +865,8 @@ const (
 	ClientDisconnected    event.Type = "client_disconnected"
 	ClientReconnected     event.Type = "client_reconnected"
 	ClientReconnectFailed event.Type = "client_reconnect_failed"
+	ShipClassAccepted     event.Type = "ship_class_accepted"
+	ShipClassRejected     event.Type = "ship_class_rejected"
 )
 
 // ChatEvent contains information about a received chat message
@@ -803,3 +877,10 @@ type ChatEvent struct {
 	TeamID     int
 	Message    string
 }
+
+// ShipClassEvent contains the server's response to a ship class request
+type ShipClassEvent struct {
+	event.BaseEvent
+	ShipClass entity.ShipClass
+	Reason    string // Set when the request was rejected
+}
@@ -3,11 +3,14 @@ package network
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/rand/v2"
 	"net"
 	"strconv"
 	"sync"
@@ -16,6 +19,7 @@ import (
 	"github.com/opd-ai/go-netrek/pkg/config"
 	"github.com/opd-ai/go-netrek/pkg/engine"
 	"github.com/opd-ai/go-netrek/pkg/entity"
+	"github.com/opd-ai/go-netrek/pkg/event"
 	"github.com/opd-ai/go-netrek/pkg/logging"
 	"github.com/opd-ai/go-netrek/pkg/physics"
 	"github.com/opd-ai/go-netrek/pkg/validation"
@@ -34,6 +38,10 @@ const (
 	PingRequest
 	PingResponse
 	RequestShipClass
+	ShipClassResponse
+	RequestRespawn
+	SetReady
+	AdminCommand
 )
 
 // GameServer handles network communication and game state
@@ -45,7 +53,8 @@ type GameServer struct {
 	running           bool
 	updateRate        time.Duration
 	maxClients        int
-	ticksPerState     int                          // How many game ticks between full state updates
+	ticksPerState     int                          // How many network frames between full state updates
+	frame             uint64                       // Network frames sent since start, paces full state updates
 	partialState      bool                         // Whether to send partial updates between full updates
 	validator         *validation.MessageValidator // Input validation and rate limiting
 	config            *config.EnvironmentConfig    // Configuration for timeouts
@@ -85,7 +94,7 @@ func NewGameServer(game *engine.Game, maxClients int) *GameServer {
 		}
 	}
 
-	return &GameServer{
+	server := &GameServer{
 		game:              game,
 		clients:           make(map[entity.ID]*Client),
 		running:           false,
@@ -100,6 +109,9 @@ func NewGameServer(game *engine.Game, maxClients int) *GameServer {
 		writeTimeout:      envConfig.WriteTimeout,
 		logger:            logger,
 	}
+	game.EventBus.Subscribe(event.TeamsUnbalanced, server.handleTeamsUnbalanced)
+	game.EventBus.Subscribe(event.MatchReset, server.handleMatchReset)
+	return server
 }
 
 // Start starts the game server
@@ -228,7 +240,7 @@ func (s *GameServer) handleConnection(conn net.Conn) {
 		return
 	}
 
-	if err := s.sendConnectionSuccessResponse(ctx, conn, playerID, client.ID); err != nil {
+	if err := s.sendConnectionSuccessResponse(ctx, conn, playerID, client.ID, client.TeamID); err != nil {
 		s.logger.Error(ctx, "Connection failed during success response", err,
 			"remote_addr", remoteAddr,
 			"player_id", playerID,
@@ -288,8 +300,10 @@ func (s *GameServer) readAndValidateConnectRequest(ctx context.Context, conn net
 	}
 	connectReq.PlayerName = sanitizedName
 
-	// Validate team ID
-	if err := validation.ValidateTeamID(connectReq.TeamID); err != nil {
+	// Validate team ID; players asking for a team to be picked are placed by the game
+	if connectReq.AutoTeam {
+		connectReq.TeamID = engine.AutoTeam
+	} else if err := validation.ValidateTeamID(connectReq.TeamID); err != nil {
 		s.logger.Error(ctx, "Invalid team ID", err,
 			"client_id", clientID,
 			"team_id", connectReq.TeamID,
@@ -312,6 +326,14 @@ func (s *GameServer) addPlayerToGame(conn net.Conn, connectReq *connectRequest)
 		s.sendConnectionErrorResponse(conn, err)
 		return 0, err
 	}
+
+	// Record the team the player ended up on, which the game picks for AutoTeam
+	teamID, err := s.game.PlayerTeamID(playerID)
+	if err != nil {
+		s.sendConnectionErrorResponse(conn, err)
+		return 0, err
+	}
+	connectReq.TeamID = teamID
 	return playerID, nil
 }
 
@@ -362,16 +384,19 @@ func (s *GameServer) sendConnectionErrorResponse(conn net.Conn, err error) {
 	}
 }
 
-// sendConnectionSuccessResponse sends a success response for established connections.
-func (s *GameServer) sendConnectionSuccessResponse(ctx context.Context, conn net.Conn, playerID, clientID entity.ID) error {
+// sendConnectionSuccessResponse sends a success response for established connections,
+// including the team the player was placed on.
+func (s *GameServer) sendConnectionSuccessResponse(ctx context.Context, conn net.Conn, playerID, clientID entity.ID, teamID int) error {
 	successResp := struct {
 		Success  bool      `json:"success"`
 		PlayerID entity.ID `json:"playerID"`
 		ClientID entity.ID `json:"clientID"`
+		TeamID   int       `json:"teamID"`
 	}{
 		Success:  true,
 		PlayerID: playerID,
 		ClientID: clientID,
+		TeamID:   teamID,
 	}
 	return s.sendMessage(ctx, conn, ConnectResponse, successResp)
 }
@@ -380,6 +405,7 @@ func (s *GameServer) sendConnectionSuccessResponse(ctx context.Context, conn net
 type connectRequest struct {
 	PlayerName string `json:"playerName"`
 	TeamID     int    `json:"teamID"`
+	AutoTeam   bool   `json:"autoTeam,omitempty"` // Join the smallest team instead of TeamID
 }
 
 // handleClientMessages processes messages from a connected client
@@ -463,6 +489,18 @@ func (s *GameServer) processClientMessage(ctx context.Context, client *Client, m
 	case ChatMessage:
 		s.broadcastChatMessage(client, data)
 
+	case RequestShipClass:
+		s.handleShipClassRequest(ctx, client, data)
+
+	case RequestRespawn:
+		s.handleRespawnRequest(ctx, client)
+
+	case SetReady:
+		s.handleReadyRequest(ctx, client, data)
+
+	case AdminCommand:
+		s.handleAdminCommand(ctx, client, data)
+
 	case DisconnectNotification:
 		s.handleClientDisconnect(ctx, client)
 
@@ -483,6 +521,177 @@ func (s *GameServer) handlePingRequest(ctx context.Context, client *Client, data
 	}
 }
 
+// shipClassRequest represents the structure of a ship class change request.
+type shipClassRequest struct {
+	ShipClass entity.ShipClass `json:"shipClass"`
+}
+
+// shipClassResponse tells the client whether its ship class request was accepted.
+type shipClassResponse struct {
+	Accepted  bool             `json:"accepted"`
+	ShipClass entity.ShipClass `json:"shipClass"`
+	Reason    string           `json:"reason,omitempty"`
+}
+
+// handleShipClassRequest validates a ship class change and replies with the outcome.
+// Accepted changes take effect on the player's next respawn.
+func (s *GameServer) handleShipClassRequest(ctx context.Context, client *Client, data []byte) {
+	var request shipClassRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		s.logger.Error(ctx, "Error parsing ship class request", err,
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+		)
+		return
+	}
+
+	response := shipClassResponse{
+		Accepted:  true,
+		ShipClass: request.ShipClass,
+	}
+	if err := s.game.RequestShipClass(client.PlayerID, request.ShipClass); err != nil {
+		response.Accepted = false
+		response.Reason = err.Error()
+	}
+
+	s.logger.Info(ctx, "Ship class change requested",
+		"client_id", client.ID,
+		"player_id", client.PlayerID,
+		"ship_class", request.ShipClass.String(),
+		"accepted", response.Accepted,
+		"reason", response.Reason,
+	)
+
+	responseCtx, responseCancel := context.WithTimeout(client.ctx, s.writeTimeout)
+	defer responseCancel()
+
+	if err := s.sendMessage(responseCtx, client.Conn, ShipClassResponse, response); err != nil {
+		s.logger.Error(ctx, "Failed to send ship class response to client", err,
+			"client_id", client.ID,
+		)
+	}
+}
+
+// handleRespawnRequest asks the game to respawn a dead player's ship.
+// The new ship appears in a later state update once the respawn delay has elapsed.
+func (s *GameServer) handleRespawnRequest(ctx context.Context, client *Client) {
+	if err := s.game.RequestRespawn(client.PlayerID); err != nil {
+		s.logger.Warn(ctx, "Respawn request rejected",
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+			"error", err,
+		)
+		return
+	}
+
+	s.logger.Info(ctx, "Respawn requested",
+		"client_id", client.ID,
+		"player_id", client.PlayerID,
+	)
+}
+
+// readyRequest is a client marking itself ready, or not, in the tournament lobby
+type readyRequest struct {
+	Ready bool `json:"ready"`
+}
+
+// handleReadyRequest marks a player ready or not in the tournament lobby. The lobby
+// and match status reach clients in the next state update.
+func (s *GameServer) handleReadyRequest(ctx context.Context, client *Client, data []byte) {
+	var request readyRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		s.logger.Error(ctx, "Error parsing ready request", err,
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+		)
+		return
+	}
+
+	if err := s.game.SetReady(client.PlayerID, request.Ready); err != nil {
+		s.logger.Warn(ctx, "Ready request rejected",
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+			"error", err,
+		)
+		return
+	}
+
+	s.logger.Info(ctx, "Player readiness changed",
+		"client_id", client.ID,
+		"player_id", client.PlayerID,
+		"ready", request.Ready,
+	)
+}
+
+// Admin commands a client can send with the admin password
+const (
+	adminPause  = "pause"
+	adminResume = "resume"
+)
+
+// adminRequest is a client asking the server to run an admin command
+type adminRequest struct {
+	Password string `json:"password"`
+	Command  string `json:"command"`
+}
+
+// handleAdminCommand runs an admin command, such as pausing the game for a tournament
+// timeout, once the password matches NETREK_ADMIN_PASSWORD. Admin commands are
+// refused when no password is configured. Clients see the result in the next state
+// update.
+func (s *GameServer) handleAdminCommand(ctx context.Context, client *Client, data []byte) {
+	var request adminRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		s.logger.Error(ctx, "Error parsing admin command", err,
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+		)
+		return
+	}
+
+	if !s.isAdminPassword(request.Password) {
+		s.logger.Warn(ctx, "Admin command rejected: bad password",
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+			"command", request.Command,
+		)
+		return
+	}
+
+	var err error
+	switch request.Command {
+	case adminPause:
+		err = s.game.Pause()
+	case adminResume:
+		err = s.game.Resume()
+	default:
+		err = fmt.Errorf("unknown admin command %q", request.Command)
+	}
+	if err != nil {
+		s.logger.Warn(ctx, "Admin command failed",
+			"client_id", client.ID,
+			"player_id", client.PlayerID,
+			"command", request.Command,
+			"error", err,
+		)
+		return
+	}
+
+	s.logger.Info(ctx, "Admin command run",
+		"client_id", client.ID,
+		"player_id", client.PlayerID,
+		"command", request.Command,
+	)
+}
+
+// isAdminPassword reports whether password matches the configured admin password.
+func (s *GameServer) isAdminPassword(password string) bool {
+	if s.config == nil || s.config.AdminPassword == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(password), []byte(s.config.AdminPassword)) == 1
+}
+
 // handleClientDisconnect handles graceful client disconnection
 func (s *GameServer) handleClientDisconnect(ctx context.Context, client *Client) {
 	s.logger.Info(ctx, "Client disconnecting",
@@ -502,14 +711,24 @@ func (s *GameServer) handleUnknownMessageType(ctx context.Context, client *Clien
 
 // PlayerInputData represents the structure of player input messages
 type PlayerInputData struct {
-	Thrust     bool      `json:"thrust"`
-	TurnLeft   bool      `json:"turnLeft"`
-	TurnRight  bool      `json:"turnRight"`
-	FireWeapon int       `json:"fireWeapon"` // -1 if not firing, weapon index otherwise
-	BeamDown   bool      `json:"beamDown"`
-	BeamUp     bool      `json:"beamUp"`
-	BeamAmount int       `json:"beamAmount"`
-	TargetID   entity.ID `json:"targetID"` // Target planet ID for beaming
+	Thrust      bool      `json:"thrust"`
+	TurnLeft    bool      `json:"turnLeft"`
+	TurnRight   bool      `json:"turnRight"`
+	FireWeapon  int       `json:"fireWeapon"` // -1 if not firing, weapon index otherwise
+	BeamDown    bool      `json:"beamDown"`
+	BeamUp      bool      `json:"beamUp"`
+	BeamAmount  int       `json:"beamAmount"`
+	TargetID    entity.ID `json:"targetID"`    // Target planet ID for beaming
+	Cloak       bool      `json:"cloak"`       // Keep the cloaking device engaged
+	Warp        bool      `json:"warp"`        // Keep the warp drive engaged
+	ShieldsDown bool      `json:"shieldsDown"` // Keep the shields lowered; they are up by default
+	Orbit       bool      `json:"orbit"`       // Enter orbit around the nearest planet
+	Dock        bool      `json:"dock"`        // Dock with the nearest friendly starbase
+	AimID       entity.ID `json:"aimID"`       // Ship to fire at, 0 fires along the heading
+	Detonate    bool      `json:"detonate"`    // Detonate enemy torpedoes near the ship
+	DetOwn      bool      `json:"detOwn"`      // Detonate the ship's own torpedoes
+	Tractor     entity.ID `json:"tractor"`     // Ship or planet to hold with a tractor beam, 0 releases it
+	Pressor     bool      `json:"pressor"`     // Push the Tractor target away instead of pulling it
 }
 
 // handlePlayerInput processes player input messages
@@ -572,12 +791,31 @@ func (s *GameServer) findPlayerShip(client *Client) *entity.Ship {
 
 // applyPlayerInput applies all validated input commands to the player's ship
 func (s *GameServer) applyPlayerInput(ship *entity.Ship, input *PlayerInputData) {
+	s.applyShipControls(ship, input)
+
+	// Orbit, weapons and beaming go through the game API, which takes the entity lock itself
+	s.applyOrbitInput(ship, input)
+	s.applyDockInput(ship, input)
+	s.applyTractorInput(ship, input)
+	s.applyDetonateInput(ship, input)
+	s.applyWeaponInput(ship, input)
+	s.applyBeamingInput(ship, input)
+}
+
+// applyShipControls sets the ship's control state under the game's entity lock.
+// Controls are ignored while the game is paused.
+func (s *GameServer) applyShipControls(ship *entity.Ship, input *PlayerInputData) {
 	s.game.EntityLock.Lock()
 	defer s.game.EntityLock.Unlock()
 
+	if s.game.Paused {
+		return
+	}
+
 	s.applyMovementInput(ship, input)
-	s.applyWeaponInput(ship, input)
-	s.applyBeamingInput(ship, input)
+	s.applyCloakInput(ship, input)
+	s.applyWarpInput(ship, input)
+	s.applyShieldInput(ship, input)
 }
 
 // applyMovementInput updates ship movement controls based on player input
@@ -587,11 +825,64 @@ func (s *GameServer) applyMovementInput(ship *entity.Ship, input *PlayerInputDat
 	ship.TurningCCW = input.TurnLeft
 }
 
+// applyCloakInput engages or disengages the cloaking device based on player input
+func (s *GameServer) applyCloakInput(ship *entity.Ship, input *PlayerInputData) {
+	ship.SetCloak(input.Cloak)
+}
+
+// applyWarpInput engages or disengages the warp drive based on player input
+func (s *GameServer) applyWarpInput(ship *entity.Ship, input *PlayerInputData) {
+	ship.SetWarp(input.Warp)
+}
+
+// applyOrbitInput locks the ship into orbit when the player requests it
+func (s *GameServer) applyOrbitInput(ship *entity.Ship, input *PlayerInputData) {
+	if input.Orbit {
+		s.game.Orbit(ship.ID)
+	}
+}
+
+// applyDockInput docks with the nearest friendly starbase when the player requests it
+func (s *GameServer) applyDockInput(ship *entity.Ship, input *PlayerInputData) {
+	if input.Dock {
+		s.game.Dock(ship.ID)
+	}
+}
+
+// applyDetonateInput detonates enemy or own torpedoes when the player requests it
+func (s *GameServer) applyDetonateInput(ship *entity.Ship, input *PlayerInputData) {
+	if input.Detonate {
+		s.game.DetonateEnemyTorpedoes(ship.ID)
+	}
+	if input.DetOwn {
+		s.game.DetonateOwnTorpedoes(ship.ID)
+	}
+}
+
+// applyTractorInput holds a tractor or pressor beam on the requested target, or releases it
+func (s *GameServer) applyTractorInput(ship *entity.Ship, input *PlayerInputData) {
+	if input.Tractor == 0 {
+		s.game.ReleaseTractor(ship.ID)
+		return
+	}
+	s.game.SetTractor(ship.ID, input.Tractor, input.Pressor)
+}
+
+// applyShieldInput raises or lowers the shields based on player input
+func (s *GameServer) applyShieldInput(ship *entity.Ship, input *PlayerInputData) {
+	ship.SetShields(!input.ShieldsDown)
+}
+
 // applyWeaponInput handles weapon firing commands from player input
 func (s *GameServer) applyWeaponInput(ship *entity.Ship, input *PlayerInputData) {
-	if input.FireWeapon >= 0 {
-		s.game.FireWeapon(ship.ID, input.FireWeapon)
+	if input.FireWeapon < 0 {
+		return
+	}
+	if input.AimID != 0 {
+		s.game.FireWeaponAt(ship.ID, input.FireWeapon, input.AimID)
+		return
 	}
+	s.game.FireWeapon(ship.ID, input.FireWeapon)
 }
 
 // applyBeamingInput processes army beaming commands from player input
@@ -678,6 +969,74 @@ func (s *GameServer) broadcastChatMessage(sender *Client, data []byte) {
 	s.clientsLock.RUnlock()
 }
 
+// handleMatchReset refreshes each client's team once the galaxy has been reset for a
+// new match, since players whose team was rotated out are moved to another. The game
+// publishes the event while holding its lock, so the refresh runs in the background.
+func (s *GameServer) handleMatchReset(e event.Event) {
+	go s.syncClientTeams()
+}
+
+// syncClientTeams updates each connected client's team from the game.
+func (s *GameServer) syncClientTeams() {
+	s.clientsLock.Lock()
+	defer s.clientsLock.Unlock()
+	for _, client := range s.clients {
+		if teamID, err := s.game.PlayerTeamID(client.PlayerID); err == nil {
+			client.TeamID = teamID
+		}
+	}
+}
+
+// handleTeamsUnbalanced prompts the players on the larger team to rebalance when team
+// sizes drift apart. The game publishes the event while holding its lock, so the
+// prompts are sent in the background.
+func (s *GameServer) handleTeamsUnbalanced(e event.Event) {
+	balance, ok := e.(*event.TeamBalanceEvent)
+	if !ok {
+		return
+	}
+	go s.sendRebalancePrompt(balance)
+}
+
+// sendRebalancePrompt sends a server chat message to every client on the larger team
+// suggesting a player rejoin on the smaller team.
+func (s *GameServer) sendRebalancePrompt(balance *event.TeamBalanceEvent) {
+	prompt := struct {
+		SenderID   entity.ID `json:"senderID"`
+		SenderName string    `json:"senderName"`
+		TeamID     int       `json:"teamID"`
+		Message    string    `json:"message"`
+	}{
+		SenderName: "Server",
+		TeamID:     balance.LargerTeamID,
+		Message: fmt.Sprintf("Teams are unbalanced (%d players against %d); please consider rejoining on %s",
+			balance.LargerSize, balance.SmallerSize, s.teamName(balance.SmallerTeamID)),
+	}
+
+	s.clientsLock.RLock()
+	defer s.clientsLock.RUnlock()
+	for _, client := range s.clients {
+		if !client.Connected || client.TeamID != balance.LargerTeamID {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(client.ctx, s.writeTimeout)
+		if err := s.sendMessage(ctx, client.Conn, ChatMessage, prompt); err != nil {
+			s.logger.Error(ctx, "Failed to send rebalance prompt to client", err,
+				"client_id", client.ID,
+			)
+		}
+		cancel()
+	}
+}
+
+// teamName returns a team's configured name, or its ID if it has none.
+func (s *GameServer) teamName(teamID int) string {
+	if teamID >= 0 && teamID < len(s.game.Config.Teams) && s.game.Config.Teams[teamID].Name != "" {
+		return s.game.Config.Teams[teamID].Name
+	}
+	return "team " + strconv.Itoa(teamID)
+}
+
 // removeClient removes a client from the server
 func (s *GameServer) removeClient(client *Client) {
 	// Cancel client context to clean up any ongoing operations
@@ -708,11 +1067,12 @@ func (s *GameServer) gameLoop() {
 	for s.running {
 		<-ticker.C
 
-		// Update game state
-		s.game.Update()
+		// Run however many fixed game ticks are due on the game clock
+		s.game.Advance()
 
 		// Send updates to clients
-		if s.game.CurrentTick%uint64(s.ticksPerState) == 0 {
+		s.frame++
+		if s.frame%uint64(s.ticksPerState) == 0 {
 			// Full state update
 			s.sendFullStateUpdate()
 		} else if s.partialState {
@@ -730,9 +1090,11 @@ func (s *GameServer) sendFullStateUpdate() {
 	s.clientsLock.RLock()
 	for _, client := range s.clients {
 		if client.Connected {
+			fullState := s.createFullStateForClient(client, gameState)
+
 			// Use client context with write timeout
 			sendCtx, cancel := context.WithTimeout(client.ctx, s.writeTimeout)
-			if err := s.sendMessage(sendCtx, client.Conn, GameStateUpdate, gameState); err != nil {
+			if err := s.sendMessage(sendCtx, client.Conn, GameStateUpdate, fullState); err != nil {
 				s.logger.Error(ctx, "Failed to send full state update to client", err,
 					"client_id", client.ID,
 				)
@@ -769,13 +1131,32 @@ func (s *GameServer) sendPartialStateUpdate() {
 	}
 }
 
+// createFullStateForClient creates a complete game state as seen by the client.
+// Everything is included except cloaked enemy ships the client cannot detect and
+// the flags they carry.
+func (s *GameServer) createFullStateForClient(client *Client, currentState *engine.GameState) *engine.GameState {
+	fullState := *currentState
+	fullState.Ships = make(map[entity.ID]engine.ShipState, len(currentState.Ships))
+	playerShipPos, hasShip := s.findPlayerShipPosition(client, currentState)
+
+	for id, ship := range currentState.Ships {
+		if visible, ok := s.visibleShipState(client, ship, playerShipPos, hasShip); ok {
+			fullState.Ships[id] = visible
+		}
+	}
+	fullState.Flags = s.visibleFlags(client, currentState, fullState.Ships)
+
+	return &fullState
+}
+
 // createPartialStateForClient creates a partial game state containing only entities visible to the client.
 func (s *GameServer) createPartialStateForClient(client *Client, currentState *engine.GameState) *engine.GameState {
 	partialState := s.initializePartialState(currentState)
-	playerShipPos := s.findPlayerShipPosition(client, currentState)
+	playerShipPos, hasShip := s.findPlayerShipPosition(client, currentState)
 
-	s.addNearbyEntities(partialState, currentState, playerShipPos)
+	s.addNearbyEntities(client, partialState, currentState, playerShipPos, hasShip)
 	s.addAllPlanets(partialState, currentState)
+	partialState.Flags = s.visibleFlags(client, currentState, partialState.Ships)
 
 	return partialState
 }
@@ -783,35 +1164,88 @@ func (s *GameServer) createPartialStateForClient(client *Client, currentState *e
 // initializePartialState creates an empty partial state with basic information.
 func (s *GameServer) initializePartialState(currentState *engine.GameState) *engine.GameState {
 	return &engine.GameState{
-		Tick:        currentState.Tick,
-		Ships:       make(map[entity.ID]engine.ShipState),
-		Planets:     make(map[entity.ID]engine.PlanetState),
-		Projectiles: make(map[entity.ID]engine.ProjectileState),
-		Teams:       currentState.Teams, // Teams always included
+		Tick:          currentState.Tick,
+		Ships:         make(map[entity.ID]engine.ShipState),
+		Planets:       make(map[entity.ID]engine.PlanetState),
+		Projectiles:   make(map[entity.ID]engine.ProjectileState),
+		Teams:         currentState.Teams,         // Teams always included
+		RespawnTimers: currentState.RespawnTimers, // Respawn countdowns always included
+		Status:        currentState.Status,        // Match status, lobby and results always included
+		Lobby:         currentState.Lobby,
+		Results:       currentState.Results,
+		Paused:        currentState.Paused, // Pause flag always included
 	}
 }
 
 // findPlayerShipPosition locates the position of the client's ship for visibility calculations.
-func (s *GameServer) findPlayerShipPosition(client *Client, currentState *engine.GameState) physics.Vector2D {
+// It reports false, with a zero vector, when the client has no ship in play.
+func (s *GameServer) findPlayerShipPosition(client *Client, currentState *engine.GameState) (physics.Vector2D, bool) {
 	for _, player := range s.game.Teams[client.TeamID].Players {
 		if player.ID == client.PlayerID {
 			if ship, ok := currentState.Ships[player.ShipID]; ok {
-				return ship.Position
+				return ship.Position, true
 			}
 			break
 		}
 	}
-	return physics.Vector2D{} // Return zero vector if ship not found
+	return physics.Vector2D{}, false
+}
+
+// cloakPositionJitter is the largest error added to a detected cloaked ship's position
+const cloakPositionJitter = 100.0
+
+// visibleShipState returns the state of a ship as the client may see it, or false if hidden.
+// Cloaked enemies are only sent when within engine.CloakDetectionRadius of the client's ship,
+// and then with a jittered position and no velocity so they cannot be tracked precisely.
+func (s *GameServer) visibleShipState(client *Client, ship engine.ShipState, viewerPos physics.Vector2D, hasShip bool) (engine.ShipState, bool) {
+	if !ship.Cloaked || ship.TeamID == client.TeamID {
+		return ship, true
+	}
+	if !hasShip || ship.Position.Distance(viewerPos) > engine.CloakDetectionRadius {
+		return ship, false
+	}
+
+	jitter := physics.FromAngle(rand.Float64()*2*math.Pi, rand.Float64()*cloakPositionJitter)
+	ship.Position = ship.Position.Add(jitter)
+	ship.Velocity = physics.Vector2D{}
+	return ship, true
+}
+
+// visibleFlags returns the capture the flag flags as the client may see them. A flag
+// carried by a cloaked enemy is hidden along with its carrier, or follows the carrier's
+// jittered position when the client has detected it; all other flags are visible.
+// visibleShips holds the ship states already filtered for the client.
+func (s *GameServer) visibleFlags(client *Client, currentState *engine.GameState, visibleShips map[entity.ID]engine.ShipState) map[entity.ID]engine.FlagState {
+	if currentState.Flags == nil {
+		return nil
+	}
+
+	flags := make(map[entity.ID]engine.FlagState, len(currentState.Flags))
+	for id, flag := range currentState.Flags {
+		carrier, ok := currentState.Ships[flag.CarrierID]
+		if ok && carrier.Cloaked && carrier.TeamID != client.TeamID {
+			seen, detected := visibleShips[carrier.ID]
+			if !detected {
+				continue
+			}
+			flag.Position = seen.Position
+		}
+		flags[id] = flag
+	}
+	return flags
 }
 
 // addNearbyEntities adds ships and projectiles within the view radius to the partial state.
-func (s *GameServer) addNearbyEntities(partialState, currentState *engine.GameState, playerPos physics.Vector2D) {
+func (s *GameServer) addNearbyEntities(client *Client, partialState, currentState *engine.GameState, playerPos physics.Vector2D, hasShip bool) {
 	viewRadius := 3000.0 // Default view radius
 
-	// Add nearby ships
+	// Add nearby ships the client can see
 	for id, ship := range currentState.Ships {
-		if ship.Position.Distance(playerPos) <= viewRadius {
-			partialState.Ships[id] = ship
+		if ship.Position.Distance(playerPos) > viewRadius {
+			continue
+		}
+		if visible, ok := s.visibleShipState(client, ship, playerPos, hasShip); ok {
+			partialState.Ships[id] = visible
 		}
 	}
 
@@ -821,6 +1255,20 @@ func (s *GameServer) addNearbyEntities(partialState, currentState *engine.GameSt
 			partialState.Projectiles[id] = proj
 		}
 	}
+
+	// Add phaser beams starting or ending nearby
+	for _, beam := range currentState.PhaserBeams {
+		if beam.Start.Distance(playerPos) <= viewRadius || beam.End.Distance(playerPos) <= viewRadius {
+			partialState.PhaserBeams = append(partialState.PhaserBeams, beam)
+		}
+	}
+
+	// Add explosions whose blast reaches into view
+	for _, explosion := range currentState.Explosions {
+		if explosion.Position.Distance(playerPos) <= viewRadius+explosion.Radius {
+			partialState.Explosions = append(partialState.Explosions, explosion)
+		}
+	}
 }
 
 // addAllPlanets includes all planets in the partial state as they are always visible.
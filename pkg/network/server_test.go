@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -114,3 +115,268 @@ func TestGameServer_HandlePlayerInput(t *testing.T) {
 	t.Logf("After input: Thrust=%v, TurnCW=%v, TurnCCW=%v",
 		ship.Thrusting, ship.TurningCW, ship.TurningCCW)
 }
+
+func TestGameServer_HandleShipClassRequest_RepliesWithOutcome(t *testing.T) {
+	cfg := config.DefaultConfig()
+	game := engine.NewGame(cfg)
+	playerID, err := game.AddPlayer("Pilot", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	server := NewGameServer(game, 8)
+
+	tests := []struct {
+		name     string
+		class    entity.ShipClass
+		accepted bool
+	}{
+		{"configured class while dead", entity.Destroyer, true},
+		{"class not configured", entity.Battleship, false},
+	}
+
+	// Kill the ship so the request is allowed anywhere
+	game.Ships[game.Teams[0].Players[playerID].ShipID].Active = false
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			conn := newMockConn()
+			client := &Client{ID: 1, Conn: conn, PlayerID: playerID, TeamID: 0, ctx: context.Background()}
+
+			data, _ := json.Marshal(shipClassRequest{ShipClass: tc.class})
+			server.handleShipClassRequest(context.Background(), client, data)
+
+			out := conn.writeBuf.Bytes()
+			if len(out) < 3 || MessageType(out[0]) != ShipClassResponse {
+				t.Fatalf("expected ShipClassResponse message, got %v", out)
+			}
+			var resp shipClassResponse
+			if err := json.Unmarshal(out[3:], &resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Accepted != tc.accepted {
+				t.Errorf("expected accepted=%v, got %v (%s)", tc.accepted, resp.Accepted, resp.Reason)
+			}
+		})
+	}
+}
+
+func TestGameServer_CloakedShipVisibility(t *testing.T) {
+	cfg := config.DefaultConfig()
+	game := engine.NewGame(cfg)
+	server := NewGameServer(game, 8)
+
+	viewerID, err := game.AddPlayer("viewer", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	client := &Client{ID: 1, PlayerID: viewerID, TeamID: 0}
+
+	viewer := game.Teams[0].Players[viewerID]
+	viewerPos := game.Ships[viewer.ShipID].Position
+
+	cloakedShip := func(id entity.ID, teamID int, distance float64) engine.ShipState {
+		return engine.ShipState{
+			ID:       id,
+			TeamID:   teamID,
+			Cloaked:  true,
+			Position: viewerPos.Add(physics.Vector2D{X: distance}),
+			Velocity: physics.Vector2D{X: 50},
+		}
+	}
+
+	state := game.GetGameState()
+	state.Ships[1001] = cloakedShip(1001, 1, 2000) // distant enemy, hidden
+	state.Ships[1002] = cloakedShip(1002, 1, 300)  // nearby enemy, detected
+	state.Ships[1003] = cloakedShip(1003, 0, 2000) // teammate, always visible
+
+	for name, view := range map[string]*engine.GameState{
+		"partial": server.createPartialStateForClient(client, state),
+		"full":    server.createFullStateForClient(client, state),
+	} {
+		if _, ok := view.Ships[1001]; ok {
+			t.Errorf("%s: distant cloaked enemy should be hidden", name)
+		}
+		near, ok := view.Ships[1002]
+		if !ok {
+			t.Errorf("%s: nearby cloaked enemy should be detected", name)
+		} else {
+			if d := near.Position.Distance(state.Ships[1002].Position); d > cloakPositionJitter {
+				t.Errorf("%s: jitter %.1f exceeds %.1f", name, d, cloakPositionJitter)
+			}
+			if near.Velocity != (physics.Vector2D{}) {
+				t.Errorf("%s: detected cloaked enemy should not reveal its velocity", name)
+			}
+		}
+		if _, ok := view.Ships[1003]; !ok {
+			t.Errorf("%s: cloaked teammate should be visible", name)
+		}
+		if _, ok := view.Ships[viewer.ShipID]; !ok {
+			t.Errorf("%s: client's own ship should be visible", name)
+		}
+	}
+
+	if _, ok := state.Ships[1001]; !ok {
+		t.Error("filtering must not modify the shared game state")
+	}
+}
+
+func TestGameServer_CloakedCarrierHidesFlag(t *testing.T) {
+	cfg := config.DefaultConfig()
+	game := engine.NewGame(cfg)
+	server := NewGameServer(game, 8)
+
+	viewerID, err := game.AddPlayer("viewer", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	client := &Client{ID: 1, PlayerID: viewerID, TeamID: 0}
+	viewer := game.Teams[0].Players[viewerID]
+	viewerPos := game.Ships[viewer.ShipID].Position
+
+	state := game.GetGameState()
+	state.Ships[1001] = engine.ShipState{ID: 1001, TeamID: 1, Cloaked: true, Position: viewerPos.Add(physics.Vector2D{X: 2000})}
+	state.Ships[1002] = engine.ShipState{ID: 1002, TeamID: 1, Cloaked: true, Position: viewerPos.Add(physics.Vector2D{X: 300})}
+	state.Flags = map[entity.ID]engine.FlagState{
+		2001: {ID: 2001, TeamID: 0, CarrierID: 1001, Position: state.Ships[1001].Position}, // hidden carrier
+		2002: {ID: 2002, TeamID: 0, CarrierID: 1002, Position: state.Ships[1002].Position}, // detected carrier
+		2003: {ID: 2003, TeamID: 1, AtHome: true},
+	}
+
+	for name, view := range map[string]*engine.GameState{
+		"partial": server.createPartialStateForClient(client, state),
+		"full":    server.createFullStateForClient(client, state),
+	} {
+		if _, ok := view.Flags[2001]; ok {
+			t.Errorf("%s: a flag carried by a hidden cloaked enemy should be hidden", name)
+		}
+		if flag, ok := view.Flags[2002]; !ok || flag.Position != view.Ships[1002].Position {
+			t.Errorf("%s: a flag carried by a detected enemy should follow its jittered position", name)
+		}
+		if _, ok := view.Flags[2003]; !ok {
+			t.Errorf("%s: a flag at home should be visible", name)
+		}
+	}
+
+	if state.Flags[2002].Position != state.Ships[1002].Position {
+		t.Error("filtering must not modify the shared game state")
+	}
+}
+
+func TestGameServer_HandlePlayerInput_CloakAndFire(t *testing.T) {
+	cfg := config.DefaultConfig()
+	game := engine.NewGame(cfg)
+	server := NewGameServer(game, 8)
+
+	playerID, err := game.AddPlayer("pilot", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	client := &Client{ID: 1, PlayerID: playerID, TeamID: 0}
+	ship := game.Ships[game.Teams[0].Players[playerID].ShipID]
+
+	input, _ := json.Marshal(PlayerInputData{FireWeapon: 0, Cloak: true})
+	server.handlePlayerInput(client, input)
+
+	if !ship.Cloaked {
+		t.Error("expected cloak input to cloak the ship")
+	}
+	if len(game.Projectiles) != 0 {
+		t.Error("cloaked ship should not be able to fire")
+	}
+
+	input, _ = json.Marshal(PlayerInputData{FireWeapon: 0})
+	server.handlePlayerInput(client, input)
+
+	if ship.Cloaked {
+		t.Error("expected ship to decloak")
+	}
+	if len(game.Projectiles) != 1 {
+		t.Errorf("expected 1 projectile after decloaking, got %d", len(game.Projectiles))
+	}
+}
+
+func TestGameServer_HandlePlayerInput_IgnoredWhilePaused(t *testing.T) {
+	game := engine.NewGame(config.DefaultConfig())
+	server := NewGameServer(game, 8)
+	game.Start()
+
+	playerID, err := game.AddPlayer("pilot", 0)
+	if err != nil {
+		t.Fatalf("AddPlayer failed: %v", err)
+	}
+	client := &Client{ID: 1, PlayerID: playerID, TeamID: 0}
+	ship := game.Ships[game.Teams[0].Players[playerID].ShipID]
+	if err := game.Pause(); err != nil {
+		t.Fatalf("Pause failed: %v", err)
+	}
+
+	input, _ := json.Marshal(PlayerInputData{Thrust: true, Cloak: true})
+	server.handlePlayerInput(client, input)
+
+	if ship.Cloaked || ship.Thrusting {
+		t.Error("expected ship controls to be ignored while paused")
+	}
+}
+
+func TestGameServer_HandleReadyRequest_StartsMatch(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.GameRules.Tournament = true
+	game := engine.NewGame(cfg)
+	server := NewGameServer(game, 8)
+	game.Start()
+
+	var players []entity.ID
+	for _, teamID := range []int{0, 1} {
+		playerID, err := game.AddPlayer("Pilot", teamID)
+		if err != nil {
+			t.Fatalf("AddPlayer failed: %v", err)
+		}
+		players = append(players, playerID)
+	}
+
+	data, _ := json.Marshal(readyRequest{Ready: true})
+	for i, playerID := range players {
+		client := &Client{ID: entity.ID(i + 1), Conn: newMockConn(), PlayerID: playerID, ctx: context.Background()}
+		server.handleReadyRequest(context.Background(), client, data)
+	}
+
+	if state := game.GetGameState(); state.Status != engine.GameStatusActive || state.Lobby != nil {
+		t.Errorf("expected the match to start once both teams are ready, status %v", state.Status)
+	}
+}
+
+func TestGameServer_HandleAdminCommand_PausesGame(t *testing.T) {
+	game := engine.NewGame(config.DefaultConfig())
+	server := NewGameServer(game, 8)
+	server.config.AdminPassword = "secret"
+	game.Start()
+	client := &Client{ID: 1, Conn: newMockConn(), ctx: context.Background()}
+
+	data, _ := json.Marshal(adminRequest{Password: "wrong", Command: adminPause})
+	server.handleAdminCommand(context.Background(), client, data)
+	if game.GetGameState().Paused {
+		t.Fatal("a bad admin password should not pause the game")
+	}
+
+	data, _ = json.Marshal(adminRequest{Password: "secret", Command: adminPause})
+	server.handleAdminCommand(context.Background(), client, data)
+	if !game.GetGameState().Paused {
+		t.Fatal("expected the admin to pause the game")
+	}
+	if partial := server.createPartialStateForClient(client, game.GetGameState()); !partial.Paused {
+		t.Error("partial state updates should report the game as paused")
+	}
+
+	data, _ = json.Marshal(adminRequest{Password: "secret", Command: adminResume})
+	server.handleAdminCommand(context.Background(), client, data)
+	if game.GetGameState().Paused {
+		t.Error("expected the admin to resume the game")
+	}
+
+	server.config.AdminPassword = ""
+	data, _ = json.Marshal(adminRequest{Command: adminPause})
+	server.handleAdminCommand(context.Background(), client, data)
+	if game.GetGameState().Paused {
+		t.Error("admin commands should be refused when no password is configured")
+	}
+}
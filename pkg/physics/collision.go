@@ -1,6 +1,8 @@
 // pkg/physics/collision.go
 package physics
 
+import "math"
+
 // Circle represents a circular collision shape
 type Circle struct {
 	Center Vector2D
@@ -46,6 +48,33 @@ func CheckCollision(a, b Circle) CollisionResult {
 	}
 }
 
+// RayCircleIntersection casts a ray from origin along direction and returns the
+// distance to the point where it first enters the circle. The direction does not
+// need to be normalized. A ray starting inside the circle hits it at distance 0.
+func RayCircleIntersection(origin, direction Vector2D, circle Circle) (float64, bool) {
+	if direction.LengthSquared() == 0 {
+		return 0, false
+	}
+	dir := direction.Normalize()
+
+	// Solve |origin + t*dir - center|² = radius² for the smallest t >= 0
+	toOrigin := origin.Sub(circle.Center)
+	b := toOrigin.Dot(dir)
+	c := toOrigin.LengthSquared() - circle.Radius*circle.Radius
+	if c <= 0 {
+		return 0, true // Origin is inside the circle
+	}
+	if b > 0 {
+		return 0, false // Circle is behind the ray
+	}
+
+	discriminant := b*b - c
+	if discriminant < 0 {
+		return 0, false // Ray misses the circle
+	}
+	return -b - math.Sqrt(discriminant), true
+}
+
 // QuadTree for spatial partitioning
 type QuadTree struct {
 	Boundary  Rect
@@ -2,6 +2,7 @@
 package physics
 
 import (
+	"math"
 	"testing"
 )
 
@@ -112,6 +113,38 @@ func TestCheckCollision(t *testing.T) {
 	})
 }
 
+func TestRayCircleIntersection(t *testing.T) {
+	circle := Circle{Center: Vector2D{X: 100, Y: 0}, Radius: 10}
+
+	tests := []struct {
+		name      string
+		origin    Vector2D
+		direction Vector2D
+		wantHit   bool
+		wantDist  float64
+	}{
+		{"head on", Vector2D{X: 0, Y: 0}, Vector2D{X: 1, Y: 0}, true, 90},
+		{"unnormalized direction", Vector2D{X: 0, Y: 0}, Vector2D{X: 50, Y: 0}, true, 90},
+		{"grazing edge", Vector2D{X: 0, Y: 10}, Vector2D{X: 1, Y: 0}, true, 100},
+		{"passes beside", Vector2D{X: 0, Y: 11}, Vector2D{X: 1, Y: 0}, false, 0},
+		{"pointing away", Vector2D{X: 0, Y: 0}, Vector2D{X: -1, Y: 0}, false, 0},
+		{"origin inside", Vector2D{X: 95, Y: 0}, Vector2D{X: -1, Y: 0}, true, 0},
+		{"zero direction", Vector2D{X: 0, Y: 0}, Vector2D{}, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dist, hit := RayCircleIntersection(tt.origin, tt.direction, circle)
+			if hit != tt.wantHit {
+				t.Fatalf("RayCircleIntersection() hit = %v, want %v", hit, tt.wantHit)
+			}
+			if hit && math.Abs(dist-tt.wantDist) > 1e-9 {
+				t.Errorf("RayCircleIntersection() distance = %v, want %v", dist, tt.wantDist)
+			}
+		})
+	}
+}
+
 func TestRect_Contains(t *testing.T) {
 	rect := Rect{
 		Center: Vector2D{X: 10, Y: 10},
@@ -121,6 +121,7 @@ func (am *AssetManager) loadShipSprites() error {
 	am.shipSprites[entity.Cruiser] = am.shipSprites[entity.Destroyer]
 	am.shipSprites[entity.Battleship] = am.shipSprites[entity.Destroyer]
 	am.shipSprites[entity.Assault] = am.shipSprites[entity.Destroyer]
+	am.shipSprites[entity.Starbase] = am.shipSprites[entity.Destroyer]
 
 	return nil
 }
@@ -22,6 +22,11 @@ type InputSystem struct {
 	turnRightPressed bool
 	currentWeapon    int
 	targetID         entity.ID
+	cloakEngaged     bool
+	warpEngaged      bool
+	shieldsLowered   bool
+	tractorEngaged   bool
+	pressorEngaged   bool
 
 	// Input timing
 	lastInputSent time.Time
@@ -84,6 +89,29 @@ func (is *InputSystem) handleGameInput() {
 		}
 	}
 
+	// Cloak toggle
+	if engo.Input.Button("cloak").JustPressed() {
+		is.cloakEngaged = !is.cloakEngaged
+	}
+
+	// Warp toggle
+	if engo.Input.Button("warp").JustPressed() {
+		is.warpEngaged = !is.warpEngaged
+	}
+
+	// Shields toggle
+	if engo.Input.Button("shields").JustPressed() {
+		is.shieldsLowered = !is.shieldsLowered
+	}
+
+	// Tractor toggle on the current target, or pressor with the modifier held
+	if engo.Input.Button("tractor").JustPressed() {
+		pressor := engo.Input.Button("modifier").Down()
+		engaged := is.tractorEngaged && is.pressorEngaged == pressor
+		is.tractorEngaged = !engaged && is.targetID != 0
+		is.pressorEngaged = pressor
+	}
+
 	// Chat activation
 	if engo.Input.Button("chat").JustPressed() {
 		is.activateChat()
@@ -125,6 +153,10 @@ func (is *InputSystem) sendInputToServer() {
 		fireWeapon = is.currentWeapon
 	}
 
+	// Detonate enemy torpedoes, or your own with the modifier held
+	detonate := engo.Input.Button("detonate").JustPressed()
+	detOwn := detonate && engo.Input.Button("modifier").Down()
+
 	beamDown := engo.Input.Button("beamDown").Down()
 	beamUp := engo.Input.Button("beamUp").Down()
 	beamAmount := 1 // Default beam amount
@@ -134,17 +166,31 @@ func (is *InputSystem) sendInputToServer() {
 		beamAmount = 5
 	}
 
+	tractor := entity.ID(0)
+	if is.tractorEngaged {
+		tractor = is.targetID
+	}
+
 	// Send input to server
-	err := is.client.SendInput(
-		is.thrustPressed,
-		is.turnLeftPressed,
-		is.turnRightPressed,
-		fireWeapon,
-		beamDown,
-		beamUp,
-		beamAmount,
-		is.targetID,
-	)
+	err := is.client.SendPlayerInput(network.PlayerInputData{
+		Thrust:      is.thrustPressed,
+		TurnLeft:    is.turnLeftPressed,
+		TurnRight:   is.turnRightPressed,
+		FireWeapon:  fireWeapon,
+		BeamDown:    beamDown,
+		BeamUp:      beamUp,
+		BeamAmount:  beamAmount,
+		TargetID:    is.targetID,
+		Cloak:       is.cloakEngaged,
+		Warp:        is.warpEngaged,
+		ShieldsDown: is.shieldsLowered,
+		Orbit:       engo.Input.Button("orbit").JustPressed(),
+		Dock:        engo.Input.Button("dock").JustPressed(),
+		Detonate:    detonate && !detOwn,
+		DetOwn:      detOwn,
+		Tractor:     tractor,
+		Pressor:     is.pressorEngaged,
+	})
 	if err != nil {
 		// Log error (use fmt.Printf since engo.Log doesn't exist)
 		// fmt.Printf("Failed to send input: %v\n", err)
@@ -216,6 +262,13 @@ func SetupInputBindings() {
 	engo.Input.RegisterButton("beamDown", engo.KeyB)
 	engo.Input.RegisterButton("beamUp", engo.KeyB) // With Shift modifier
 	engo.Input.RegisterButton("target", engo.KeyT)
+	engo.Input.RegisterButton("cloak", engo.KeyC)
+	engo.Input.RegisterButton("warp", engo.KeyX)
+	engo.Input.RegisterButton("shields", engo.KeyS)
+	engo.Input.RegisterButton("orbit", engo.KeyO)
+	engo.Input.RegisterButton("dock", engo.KeyK)
+	engo.Input.RegisterButton("detonate", engo.KeyE) // With Shift modifier for own torpedoes
+	engo.Input.RegisterButton("tractor", engo.KeyY)  // With Shift modifier for pressor
 
 	// Number keys for weapon selection (simplified for now)
 	// Note: Engo key constants may differ, using a simplified approach